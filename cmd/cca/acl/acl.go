@@ -0,0 +1,49 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package acl implements the `acl` command
+package acl
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/acl/create"
+	"github.com/cloud-ca/cca/cmd/cca/acl/delete"
+	"github.com/cloud-ca/cca/cmd/cca/acl/get"
+	"github.com/cloud-ca/cca/cmd/cca/acl/list"
+	"github.com/cloud-ca/cca/cmd/cca/acl/rule"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for acl
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "acl",
+		Short: "Manage network ACLs of an environment",
+		Long: util.LongDescription(`
+            Network ACLs are ordered lists of rules allowing or denying the traffic of the networks of a
+            VPC. Each VPC has the default_allow and default_deny ACLs, and custom ones can be created.
+            ACLs can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(rule.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `acl create` command
+package create
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name        string
+	description string
+	vpc         string
+}
+
+// NewCommand returns a new cobra.Command for acl create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create network ACL",
+		Long:  "Create network ACL in a VPC, without any rule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			vpc, err := resolve.Vpc(resources.Vpcs, flg.vpc)
+			if err != nil {
+				return err
+			}
+			acl := cloudca.NetworkAcl{
+				Name:        flg.name,
+				Description: flg.description,
+				VpcId:       vpc.Id,
+			}
+			created, err := resources.NetworkAcls.Create(acl)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "network ACL name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "network ACL description")
+	cmd.Flags().StringVar(&flg.vpc, "vpc", "", "VPC name or id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "vpc"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `acl list` command
+package list
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	vpc string
+}
+
+// NewCommand returns a new cobra.Command for acl list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all network ACLs",
+		Long:    "List all network ACLs, optionally only the ones of a VPC",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			var acls []cloudca.NetworkAcl
+			if flg.vpc == "" {
+				acls, err = resources.NetworkAcls.List()
+			} else {
+				acls, err = listByVpc(resources, flg.vpc)
+			}
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(acls)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.vpc, "vpc", "", "VPC name or id the network ACLs belong to")
+
+	return cmd
+}
+
+// listByVpc lists the network ACLs of the VPC with provided name or id
+func listByVpc(resources cloudca.Resources, nameOrID string) ([]cloudca.NetworkAcl, error) {
+	vpc, err := resolve.Vpc(resources.Vpcs, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	return resources.NetworkAcls.ListByVpcId(vpc.Id)
+}
@@ -0,0 +1,78 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package add implements the `acl rule add` command
+package add
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	acl  string
+	rule string
+}
+
+// NewCommand returns a new cobra.Command for acl rule add
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Use:     "add",
+		Short:   "Add rule to network ACL",
+		Long:    "Add rule to network ACL, in the form of '" + flags.ACLRuleSyntax + "'",
+		Example: `  cca acl rule add -e dev --acl web --rule "allow tcp 0.0.0.0/0 443 ingress #100"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rule, err := flags.ParseACLRule(flg.rule)
+			if err != nil {
+				return err
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			acl, err := resolve.NetworkACL(resources.NetworkAcls, flg.acl)
+			if err != nil {
+				return err
+			}
+			rule.NetworkAclId = acl.Id
+			created, err := resources.NetworkAclRules.Create(*rule)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.acl, "acl", "", "network ACL name or id")
+	cmd.Flags().StringVar(&flg.rule, "rule", "", "rule in the compact syntax")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"acl", "rule"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
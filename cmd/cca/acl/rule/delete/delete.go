@@ -0,0 +1,67 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package delete implements the `acl rule delete` command
+package delete
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id string
+}
+
+// NewCommand returns a new cobra.Command for acl rule delete
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "delete",
+		Short: "Delete rule of network ACL",
+		Long:  "Delete rule of network ACL",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			rule, err := resources.NetworkAclRules.Get(flg.id)
+			if err != nil {
+				return err
+			}
+			if _, err := resources.NetworkAclRules.Delete(rule.Id); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			fmt.Fprintf(os.Stderr, "Rule #%s deleted\n", rule.RuleNumber)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "rule id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
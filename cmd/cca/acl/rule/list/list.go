@@ -0,0 +1,84 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `acl rule list` command
+package list
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	acl string
+}
+
+// NewCommand returns a new cobra.Command for acl rule list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all rules of network ACL",
+		Long:    "List all rules of network ACL, in the order of their number",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			acl, err := resolve.NetworkACL(resources.NetworkAcls, flg.acl)
+			if err != nil {
+				return err
+			}
+			rules, err := resources.NetworkAclRules.ListByNetworkAclId(acl.Id)
+			if err != nil {
+				return err
+			}
+			sortByNumber(rules)
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(rules)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.acl, "acl", "", "network ACL name or id")
+
+	err := cmd.MarkFlagRequired("acl")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// sortByNumber sorts the rules by their number, which the API returns as string
+func sortByNumber(rules []cloudca.NetworkAclRule) {
+	number := func(rule cloudca.NetworkAclRule) int {
+		n, err := strconv.Atoi(rule.RuleNumber)
+		if err != nil {
+			return 0
+		}
+		return n
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return number(rules[i]) < number(rules[j])
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rule implements the `acl rule` command
+package rule
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/acl/rule/add"
+	"github.com/cloud-ca/cca/cmd/cca/acl/rule/delete"
+	"github.com/cloud-ca/cca/cmd/cca/acl/rule/list"
+	"github.com/cloud-ca/cca/cmd/cca/acl/rule/update"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for acl rule
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "rule",
+		Short: "Manage rules of network ACLs",
+		Long: util.LongDescription(`
+            Rules of a network ACL are evaluated in the order of their number, the first rule matching
+            the traffic allowing or denying it. Rules are provided in the compact syntax:
+
+              ` + flags.ACLRuleSyntax + `
+
+            For example 'allow tcp 0.0.0.0/0 443 ingress #100' or 'deny icmp 10.0.0.0/8 8:0 egress'.
+        `),
+	}
+
+	cmd.AddCommand(add.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(update.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package update implements the `acl rule update` command
+package update
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id   string
+	rule string
+}
+
+// NewCommand returns a new cobra.Command for acl rule update
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Use:     "update",
+		Short:   "Update rule of network ACL",
+		Long:    "Replace rule of network ACL, keeping its number unless provided",
+		Example: `  cca acl rule update -e dev --id 4f3c... --rule "deny tcp 0.0.0.0/0 22"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rule, err := flags.ParseACLRule(flg.rule)
+			if err != nil {
+				return err
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			existing, err := resources.NetworkAclRules.Get(flg.id)
+			if err != nil {
+				return err
+			}
+			if rule.RuleNumber == "" {
+				rule.RuleNumber = existing.RuleNumber
+			}
+			updated, err := resources.NetworkAclRules.Update(existing.Id, *rule)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(updated)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "rule id")
+	cmd.Flags().StringVar(&flg.rule, "rule", "", "rule in the compact syntax")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "rule"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package affinitygroup implements the `affinity-group` command
+package affinitygroup
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/affinitygroup/get"
+	"github.com/cloud-ca/cca/cmd/cca/affinitygroup/list"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for affinity-group
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "affinity-group",
+		Short: "List and get affinity groups of an environment",
+		Long: util.LongDescription(`
+            Affinity groups control the placement of instances on hosts, e.g. anti-affinity groups keep
+            instances on different hosts for high availability.
+            They can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+
+	return cmd
+}
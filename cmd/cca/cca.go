@@ -17,12 +17,38 @@ package cca
 
 import (
 	"os"
+	"strings"
 
+	"github.com/cloud-ca/cca/cmd/cca/acl"
+	"github.com/cloud-ca/cca/cmd/cca/affinitygroup"
 	"github.com/cloud-ca/cca/cmd/cca/completion"
+	"github.com/cloud-ca/cca/cmd/cca/computeoffering"
+	configcmd "github.com/cloud-ca/cca/cmd/cca/config"
 	"github.com/cloud-ca/cca/cmd/cca/connection"
+	"github.com/cloud-ca/cca/cmd/cca/diskoffering"
+	"github.com/cloud-ca/cca/cmd/cca/environment"
+	"github.com/cloud-ca/cca/cmd/cca/instance"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer"
+	"github.com/cloud-ca/cca/cmd/cca/login"
+	"github.com/cloud-ca/cca/cmd/cca/logout"
+	"github.com/cloud-ca/cca/cmd/cca/network"
+	"github.com/cloud-ca/cca/cmd/cca/networkoffering"
+	"github.com/cloud-ca/cca/cmd/cca/organization"
+	"github.com/cloud-ca/cca/cmd/cca/portforward"
+	"github.com/cloud-ca/cca/cmd/cca/publicip"
+	"github.com/cloud-ca/cca/cmd/cca/sshkey"
+	"github.com/cloud-ca/cca/cmd/cca/task"
+	"github.com/cloud-ca/cca/cmd/cca/template"
+	"github.com/cloud-ca/cca/cmd/cca/user"
 	"github.com/cloud-ca/cca/cmd/cca/version"
+	"github.com/cloud-ca/cca/cmd/cca/volume"
+	"github.com/cloud-ca/cca/cmd/cca/vpc"
+	"github.com/cloud-ca/cca/cmd/cca/vpcoffering"
+	"github.com/cloud-ca/cca/cmd/cca/vpn"
+	"github.com/cloud-ca/cca/cmd/cca/zone"
 	"github.com/cloud-ca/cca/pkg/cli"
 	"github.com/cloud-ca/cca/pkg/client"
+	"github.com/cloud-ca/cca/pkg/config"
 	"github.com/cloud-ca/cca/pkg/flags"
 	"github.com/cloud-ca/cca/pkg/output"
 	"github.com/sirupsen/logrus"
@@ -33,7 +59,9 @@ import (
 
 // NewCommand returns a new cobra.Command implementing the root command for cca
 func NewCommand() *cobra.Command {
-	cli := &cli.Wrapper{}
+	cli := &cli.Wrapper{
+		WaitFlags: flags.NewWaitFlags(),
+	}
 	flg := &flags.GlobalFlags{}
 	cmd := &cobra.Command{
 		Args:         cobra.NoArgs,
@@ -43,24 +71,70 @@ func NewCommand() *cobra.Command {
 		SilenceUsage: true,
 		Version:      version.Version(),
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.Load(); err != nil {
+				return err
+			}
+			flg.ApplyEnv(cmd)
 			if err := flg.Normalize(cmd, viper.Get, args); err != nil {
 				return err
 			}
 			cli.GlobalFlags = flg
-			cli.OutputBuilder = output.NewBuilder(flg.OutputFormat)
-			cli.CcaClient = client.NewClient(flg.APIURL, flg.APIKey)
+			builder, err := output.NewBuilder(os.Stdout, output.Options{
+				Format:       flg.OutputFormat,
+				Columns:      flg.Columns,
+				NoHeaders:    flg.NoHeaders,
+				TemplateFile: flg.TemplateFile,
+				Color:        flg.Colorized(),
+				OutputFile:   flg.OutputFile,
+			})
+			if err != nil {
+				return err
+			}
+			cli.OutputBuilder = builder
+			cli.CcaClient = client.NewClient(flg.APIURL, flg.APIKey, flg.Profile, cli.WaitFlags.TaskOptions())
 			return nil
 		},
 	}
 
 	cmd.PersistentFlags().StringVar(&flg.APIURL, "api-url", flags.DefaultAPIURL, "API url cloud.ca resources")
 	cmd.PersistentFlags().StringVar(&flg.APIKey, "api-key", "", "API Key to access cloud.ca resources")
-	cmd.PersistentFlags().StringVar(&flg.OutputFormat, "output", flags.DefaultOutputFormat, "output format "+output.FormatStrings())
+	cmd.PersistentFlags().StringVarP(&flg.EnvironmentID, "environment", "e", "", "environment name or id to manage resources of")
+	cmd.PersistentFlags().StringVarP(&flg.OutputFormat, "output", "o", "", "output format "+output.FormatStrings()+" (default \""+flags.DefaultTerminalOutputFormat+"\" on a terminal, \""+flags.DefaultOutputFormat+"\" otherwise)")
+	cmd.PersistentFlags().StringSliceVar(&flg.Columns, "columns", nil, "comma separated fields shown by the table, csv and tsv output formats, e.g. id,name,state")
+	cmd.PersistentFlags().BoolVar(&flg.NoHeaders, "no-headers", false, "omit the header row of the table, csv and tsv output formats")
+	cmd.PersistentFlags().StringVar(&flg.TemplateFile, "template-file", "", "file containing the template of the jsonpath and go-template output formats")
+	cmd.PersistentFlags().StringVar(&flg.OutputFile, "output-file", "", "write the output to a file instead of stdout, replacing it atomically")
+	cmd.PersistentFlags().StringVar(&flg.Color, "color", flags.DefaultColor, "colorize the output ["+strings.Join(flags.ColorModes, ", ")+"]")
 	cmd.PersistentFlags().StringVar(&flg.LogLevel, "loglevel", flags.DefaultLogLevel.String(), "log level "+logutil.LevelsString())
+	cmd.PersistentFlags().StringVar(&flg.Profile, "profile", "", "named profile of the configuration file to use (default \"current-profile\" or \""+config.DefaultProfile+"\")")
 
+	cmd.AddCommand(acl.NewCommand(cli))
+	cmd.AddCommand(affinitygroup.NewCommand(cli))
 	cmd.AddCommand(completion.NewCommand(cli))
+	cmd.AddCommand(computeoffering.NewCommand(cli))
+	cmd.AddCommand(configcmd.NewCommand(cli))
 	cmd.AddCommand(connection.NewCommand(cli))
+	cmd.AddCommand(diskoffering.NewCommand(cli))
+	cmd.AddCommand(environment.NewCommand(cli))
+	cmd.AddCommand(instance.NewCommand(cli))
+	cmd.AddCommand(loadbalancer.NewCommand(cli))
+	cmd.AddCommand(login.NewCommand(cli))
+	cmd.AddCommand(logout.NewCommand(cli))
+	cmd.AddCommand(network.NewCommand(cli))
+	cmd.AddCommand(networkoffering.NewCommand(cli))
+	cmd.AddCommand(organization.NewCommand(cli))
+	cmd.AddCommand(portforward.NewCommand(cli))
+	cmd.AddCommand(publicip.NewCommand(cli))
+	cmd.AddCommand(sshkey.NewCommand(cli))
+	cmd.AddCommand(task.NewCommand(cli))
+	cmd.AddCommand(template.NewCommand(cli))
+	cmd.AddCommand(user.NewCommand(cli))
 	cmd.AddCommand(version.NewCommand(cli))
+	cmd.AddCommand(volume.NewCommand(cli))
+	cmd.AddCommand(vpc.NewCommand(cli))
+	cmd.AddCommand(vpcoffering.NewCommand(cli))
+	cmd.AddCommand(vpn.NewCommand(cli))
+	cmd.AddCommand(zone.NewCommand(cli))
 
 	return cmd
 }
@@ -72,8 +146,9 @@ func Run() error {
 
 // Main wraps Run and sets the log formatter
 func Main() {
-	// let's explicitly set stdout
-	logrus.SetOutput(os.Stdout)
+	// logs go to stderr so that they never get mixed with the output,
+	// e.g. warnings about flags corrupting the JSON parsed by a script
+	logrus.SetOutput(os.Stderr)
 
 	// this formatter is the default, but the timestamps output aren't
 	// particularly useful, they're relative to the command start
@@ -87,6 +162,6 @@ func Main() {
 	})
 
 	if err := Run(); err != nil {
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
@@ -0,0 +1,54 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config implements the `config` command
+package config
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/config/deleteprofile"
+	"github.com/cloud-ca/cca/cmd/cca/config/get"
+	"github.com/cloud-ca/cca/cmd/cca/config/listprofiles"
+	"github.com/cloud-ca/cca/cmd/cca/config/set"
+	"github.com/cloud-ca/cca/cmd/cca/config/unset"
+	"github.com/cloud-ca/cca/cmd/cca/config/useprofile"
+	"github.com/cloud-ca/cca/cmd/cca/config/view"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for config
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "config",
+		Short: "Manage named profiles of the configuration file",
+		Long: util.LongDescription(`
+            Manage named profiles of the configuration file located at ~/.cca/config.yaml. Each profile
+            holds values for the global flags (api-url, api-key, environment, output, loglevel) and the
+            settings are applied to the profile selected with --profile flag, CCA_PROFILE environment
+            variable or the current profile.
+        `),
+	}
+
+	cmd.AddCommand(deleteprofile.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(listprofiles.NewCommand(cli))
+	cmd.AddCommand(set.NewCommand(cli))
+	cmd.AddCommand(unset.NewCommand(cli))
+	cmd.AddCommand(useprofile.NewCommand(cli))
+	cmd.AddCommand(view.NewCommand(cli))
+
+	return cmd
+}
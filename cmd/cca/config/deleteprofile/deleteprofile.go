@@ -0,0 +1,50 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deleteprofile implements the `config delete-profile` command
+package deleteprofile
+
+import (
+	"fmt"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for config delete-profile
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "delete-profile <name>",
+		Short: "Delete a profile",
+		Long:  "Delete a profile, and unset the current profile if it was the one deleted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Profiles[args[0]]; !ok {
+				return fmt.Errorf("profile '%s' does not exist", args[0])
+			}
+			delete(cfg.Profiles, args[0])
+			if cfg.CurrentProfile == args[0] {
+				cfg.CurrentProfile = ""
+			}
+			return cfg.Write()
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package get implements the `config get` command
+package get
+
+import (
+	"fmt"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	showSecrets bool
+}
+
+// NewCommand returns a new cobra.Command for config get
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "get <key>",
+		Short: "Print a setting of the selected profile",
+		Long:  "Print a setting of the selected profile, the API key is masked unless --show-secrets is set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			if !flg.showSecrets {
+				cfg = cfg.Masked()
+			}
+			profile, ok := cfg.Profiles[cli.GlobalFlags.Profile]
+			if !ok {
+				return fmt.Errorf("profile '%s' does not exist", cli.GlobalFlags.Profile)
+			}
+			value, err := profile.Get(args[0])
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Text(value + "\n")
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&flg.showSecrets, "show-secrets", false, "print the API key in plain text")
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package listprofiles implements the `config list-profiles` command
+package listprofiles
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+type profile struct {
+	Name    string `json:"name" yaml:"name"`
+	Current bool   `json:"current" yaml:"current"`
+}
+
+// NewCommand returns a new cobra.Command for config list-profiles
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "list-profiles",
+		Short: "List all profiles",
+		Long:  "List all profiles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			profiles := []profile{}
+			for _, name := range cfg.ProfileNames() {
+				profiles = append(profiles, profile{
+					Name:    name,
+					Current: name == cfg.CurrentProfile,
+				})
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(profiles)
+			})
+		},
+	}
+
+	return cmd
+}
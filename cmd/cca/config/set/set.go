@@ -0,0 +1,69 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package set implements the `config set` command
+package set
+
+import (
+	"fmt"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for config set
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(2),
+		Use:   "set <key> <value>",
+		Short: "Set a setting in the selected profile",
+		Long:  "Set a setting in the selected profile",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, value := args[0], args[1]
+			if err := config.ValidateProfileName(cli.GlobalFlags.Profile); err != nil {
+				return err
+			}
+			if err := validate(key, value); err != nil {
+				return err
+			}
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			if err := cfg.Profile(cli.GlobalFlags.Profile).Set(key, value); err != nil {
+				return err
+			}
+			return cfg.Write()
+		},
+	}
+
+	return cmd
+}
+
+func validate(key string, value string) error {
+	switch key {
+	case "output":
+		if !output.Has(value) {
+			return fmt.Errorf("invalid output format '%s', must be one of %s", value, output.FormatStrings())
+		}
+	case "loglevel":
+		if _, err := logrus.ParseLevel(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
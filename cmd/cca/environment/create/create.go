@@ -0,0 +1,95 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `environment create` command
+package create
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/configuration"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name         string
+	description  string
+	connection   string
+	organization string
+	roles        []string
+}
+
+// NewCommand returns a new cobra.Command for environment create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create environment",
+		Long:  "Create environment",
+		Example: `  cca environment create --name dev --connection compute-qc \
+      --role "Environment Admin=alice" --role "User=bob,carol"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			connection, err := resolve.ServiceConnection(cli.CcaClient.ServiceConnections, flg.connection)
+			if err != nil {
+				return err
+			}
+			environment := configuration.Environment{
+				Name:              flg.name,
+				Description:       flg.description,
+				ServiceConnection: configuration.ServiceConnection{Id: connection.Id},
+			}
+			if flg.organization != "" {
+				organization, err := resolve.Organization(cli.CcaClient.Organizations, flg.organization)
+				if err != nil {
+					return err
+				}
+				environment.Organization = configuration.Organization{Id: organization.Id}
+			}
+			if len(flg.roles) > 0 {
+				assignments, err := flags.ParseKeyValues(flg.roles)
+				if err != nil {
+					return err
+				}
+				environment.Roles, environment.Users, err = resolve.Roles(cli.CcaClient.Users, assignments)
+				if err != nil {
+					return err
+				}
+			}
+			created, err := cli.CcaClient.Environments.Create(environment)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "environment name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "environment description")
+	cmd.Flags().StringVar(&flg.connection, "connection", "", "service connection name, id or service code")
+	cmd.Flags().StringVar(&flg.organization, "organization", "", "organization name, id or entry point (default organization of the API key)")
+	cmd.Flags().StringArrayVar(&flg.roles, "role", []string{}, "assign users to a role in the form of 'role=user1,user2' (repeatable)")
+
+	for _, name := range []string{"name", "connection"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package environment implements the `environment` command
+package environment
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/environment/create"
+	"github.com/cloud-ca/cca/cmd/cca/environment/delete"
+	"github.com/cloud-ca/cca/cmd/cca/environment/get"
+	"github.com/cloud-ca/cca/cmd/cca/environment/list"
+	"github.com/cloud-ca/cca/cmd/cca/environment/update"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for environment
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"env"},
+		Use:     "environment",
+		Short:   "Manage environments of service connections",
+		Long: util.LongDescription(`
+            Environments are created for a specific service connection in an organization, and
+            hold the resources of that service. Users are given access to an environment through
+            its roles (e.g. Environment Admin, User, Read-only).
+        `),
+	}
+
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(update.NewCommand(cli))
+
+	return cmd
+}
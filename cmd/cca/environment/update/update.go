@@ -0,0 +1,87 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package update implements the `environment update` command
+package update
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id          string
+	name        string
+	description string
+	roles       []string
+}
+
+// NewCommand returns a new cobra.Command for environment update
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "update",
+		Short: "Update environment",
+		Long:  "Update name, description or role assignments of environment. Provided roles replace the existing assignments.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			found, err := resolve.Environment(cli.CcaClient.Environments, flg.id)
+			if err != nil {
+				return err
+			}
+			environment, err := cli.CcaClient.Environments.Get(found.Id)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("name") {
+				environment.Name = flg.name
+			}
+			if cmd.Flags().Changed("description") {
+				environment.Description = flg.description
+			}
+			if len(flg.roles) > 0 {
+				assignments, err := flags.ParseKeyValues(flg.roles)
+				if err != nil {
+					return err
+				}
+				environment.Roles, environment.Users, err = resolve.Roles(cli.CcaClient.Users, assignments)
+				if err != nil {
+					return err
+				}
+			}
+			updated, err := cli.CcaClient.Environments.Update(environment.Id, *environment)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(updated)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "environment name or id")
+	cmd.Flags().StringVar(&flg.name, "name", "", "new environment name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "new environment description")
+	cmd.Flags().StringArrayVar(&flg.roles, "role", []string{}, "assign users to a role in the form of 'role=user1,user2' (repeatable)")
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
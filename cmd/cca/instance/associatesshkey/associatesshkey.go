@@ -0,0 +1,74 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package associatesshkey implements the `instance associate-ssh-key` command
+package associatesshkey
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id     string
+	sshKey string
+}
+
+// NewCommand returns a new cobra.Command for instance associate-ssh-key
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "associate-ssh-key",
+		Short: "Associate SSH key to instance",
+		Long:  "Associate SSH key to instance, the instance must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			instance, err := resolve.Instance(resources.Instances, flg.id)
+			if err != nil {
+				return err
+			}
+			if _, err := resources.Instances.AssociateSSHKey(instance.Id, flg.sshKey); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			instance, err = resources.Instances.Get(instance.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(instance)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "instance name or id")
+	cmd.Flags().StringVar(&flg.sshKey, "ssh-key", "", "SSH key name")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "ssh-key"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package changeoffering implements the `instance change-offering` command
+package changeoffering
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id              string
+	computeOffering string
+	cpuCount        int
+	memory          int
+}
+
+// NewCommand returns a new cobra.Command for instance change-offering
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "change-offering",
+		Short: "Change compute offering of instance",
+		Long:  "Change compute offering of instance, CPU count and memory are required for custom offerings",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			instance, err := resolve.Instance(resources.Instances, flg.id)
+			if err != nil {
+				return err
+			}
+			offering, err := resolve.ComputeOffering(resources.ComputeOfferings, flg.computeOffering)
+			if err != nil {
+				return err
+			}
+			change := cloudca.Instance{
+				Id:                instance.Id,
+				ComputeOfferingId: offering.Id,
+				CpuCount:          flg.cpuCount,
+				MemoryInMB:        flg.memory,
+			}
+			if _, err := resources.Instances.ChangeComputeOffering(change); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			instance, err = resources.Instances.Get(instance.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(instance)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "instance name or id")
+	cmd.Flags().StringVar(&flg.computeOffering, "compute-offering", "", "compute offering name or id")
+	cmd.Flags().IntVar(&flg.cpuCount, "cpu-count", 0, "number of CPUs for custom compute offering")
+	cmd.Flags().IntVar(&flg.memory, "memory", 0, "memory in MB for custom compute offering")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "compute-offering"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,155 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `instance create` command
+package create
+
+import (
+	"io/ioutil"
+	"strconv"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name            string
+	template        string
+	computeOffering string
+	cpuCount        int
+	memory          int
+	network         string
+	sshKey          string
+	userDataFile    string
+	rootVolumeSize  int
+	diskOffering    string
+	diskSize        int
+	diskIops        int
+	affinityGroups  []string
+}
+
+// NewCommand returns a new cobra.Command for instance create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create instance",
+		Long:  "Create instance",
+		Example: `  cca instance create -e dev --name web-1 --template "Ubuntu 18.04.3 HVM" \
+      --compute-offering "Standard" --cpu-count 2 --memory 4096 --network web \
+      --ssh-key deployer --user-data-file ./cloud-init.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			instance, err := flg.instance(resources)
+			if err != nil {
+				return err
+			}
+			created, err := resources.Instances.Create(*instance)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "instance name")
+	cmd.Flags().StringVar(&flg.template, "template", "", "template name or id")
+	cmd.Flags().StringVar(&flg.computeOffering, "compute-offering", "", "compute offering name or id")
+	cmd.Flags().IntVar(&flg.cpuCount, "cpu-count", 0, "number of CPUs for custom compute offering")
+	cmd.Flags().IntVar(&flg.memory, "memory", 0, "memory in MB for custom compute offering")
+	cmd.Flags().StringVar(&flg.network, "network", "", "network name or id")
+	cmd.Flags().StringVar(&flg.sshKey, "ssh-key", "", "SSH key name")
+	cmd.Flags().StringVar(&flg.userDataFile, "user-data-file", "", "path to user data file (e.g. cloud-init)")
+	cmd.Flags().IntVar(&flg.rootVolumeSize, "root-volume-size", 0, "root volume size in GB (default size of the template)")
+	cmd.Flags().StringVar(&flg.diskOffering, "disk-offering", "", "disk offering name or id of an additional data volume")
+	cmd.Flags().IntVar(&flg.diskSize, "disk-size", 0, "size in GB of the additional data volume, for custom size disk offering")
+	cmd.Flags().IntVar(&flg.diskIops, "disk-iops", 0, "IOPS of the additional data volume, for custom IOPS disk offering")
+	cmd.Flags().StringArrayVar(&flg.affinityGroups, "affinity-group", []string{}, "affinity group name or id (repeatable)")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "template", "compute-offering", "network"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+// instance builds the instance to create from the flags, looking up
+// the ids of the referenced entities by their name if needed
+func (flg *flag) instance(resources cloudca.Resources) (*cloudca.Instance, error) {
+	template, err := resolve.Template(resources.Templates, flg.template)
+	if err != nil {
+		return nil, err
+	}
+	offering, err := resolve.ComputeOffering(resources.ComputeOfferings, flg.computeOffering)
+	if err != nil {
+		return nil, err
+	}
+	network, err := resolve.Network(resources.Networks, flg.network)
+	if err != nil {
+		return nil, err
+	}
+	instance := &cloudca.Instance{
+		Name:               flg.name,
+		TemplateId:         template.ID,
+		ComputeOfferingId:  offering.Id,
+		CpuCount:           flg.cpuCount,
+		MemoryInMB:         flg.memory,
+		NetworkId:          network.Id,
+		SSHKeyName:         flg.sshKey,
+		RootVolumeSizeInGb: flg.rootVolumeSize,
+	}
+	if flg.userDataFile != "" {
+		userData, err := ioutil.ReadFile(flg.userDataFile)
+		if err != nil {
+			return nil, err
+		}
+		instance.UserData = string(userData)
+	}
+	if flg.diskOffering != "" {
+		diskOffering, err := resolve.DiskOffering(resources.DiskOfferings, flg.diskOffering)
+		if err != nil {
+			return nil, err
+		}
+		instance.AdditionalDiskOfferingId = diskOffering.Id
+		if flg.diskSize > 0 {
+			instance.AdditionalDiskSizeInGb = strconv.Itoa(flg.diskSize)
+		}
+		if flg.diskIops > 0 {
+			instance.AdditionalDiskIops = strconv.Itoa(flg.diskIops)
+		}
+	}
+	for _, nameOrID := range flg.affinityGroups {
+		group, err := resolve.AffinityGroup(resources.AffinityGroups, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		instance.AffinityGroupIds = append(instance.AffinityGroupIds, group.Id)
+	}
+	return instance, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package destroy implements the `instance destroy` command
+package destroy
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id              string
+	purge           bool
+	deleteSnapshots bool
+}
+
+// NewCommand returns a new cobra.Command for instance destroy
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "destroy",
+		Short: "Destroy instance",
+		Long:  "Destroy instance. Unless purged, it can be recovered until it is automatically purged.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			instance, err := resolve.Instance(resources.Instances, flg.id)
+			if err != nil {
+				return err
+			}
+			options := cloudca.DestroyOptions{
+				PurgeImmediately: flg.purge,
+				DeleteSnapshots:  flg.deleteSnapshots,
+			}
+			if _, err := resources.Instances.DestroyWithOptions(instance.Id, options); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			fmt.Fprintf(os.Stderr, "Instance '%s' destroyed\n", instance.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "instance name or id")
+	cmd.Flags().BoolVar(&flg.purge, "purge", false, "purge instance immediately, it cannot be recovered")
+	cmd.Flags().BoolVar(&flg.deleteSnapshots, "delete-snapshots", false, "delete snapshots of the instance volumes")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
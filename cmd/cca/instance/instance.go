@@ -0,0 +1,68 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package instance implements the `instance` command
+package instance
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/instance/associatesshkey"
+	"github.com/cloud-ca/cca/cmd/cca/instance/changenetwork"
+	"github.com/cloud-ca/cca/cmd/cca/instance/changeoffering"
+	"github.com/cloud-ca/cca/cmd/cca/instance/create"
+	"github.com/cloud-ca/cca/cmd/cca/instance/createrecoverypoint"
+	"github.com/cloud-ca/cca/cmd/cca/instance/destroy"
+	"github.com/cloud-ca/cca/cmd/cca/instance/get"
+	"github.com/cloud-ca/cca/cmd/cca/instance/list"
+	"github.com/cloud-ca/cca/cmd/cca/instance/purge"
+	"github.com/cloud-ca/cca/cmd/cca/instance/reboot"
+	"github.com/cloud-ca/cca/cmd/cca/instance/recover"
+	"github.com/cloud-ca/cca/cmd/cca/instance/resetpassword"
+	"github.com/cloud-ca/cca/cmd/cca/instance/start"
+	"github.com/cloud-ca/cca/cmd/cca/instance/stop"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for instance
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"vm"},
+		Use:     "instance",
+		Short:   "Manage instances of an environment",
+		Long: util.LongDescription(`
+            Instances are the virtual machines of a compute environment. They are created from a template
+            with a compute offering (CPU and memory) in a network of the environment selected with the
+            --environment flag. Instances can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(associatesshkey.NewCommand(cli))
+	cmd.AddCommand(changenetwork.NewCommand(cli))
+	cmd.AddCommand(changeoffering.NewCommand(cli))
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(createrecoverypoint.NewCommand(cli))
+	cmd.AddCommand(destroy.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(purge.NewCommand(cli))
+	cmd.AddCommand(reboot.NewCommand(cli))
+	cmd.AddCommand(recover.NewCommand(cli))
+	cmd.AddCommand(resetpassword.NewCommand(cli))
+	cmd.AddCommand(start.NewCommand(cli))
+	cmd.AddCommand(stop.NewCommand(cli))
+
+	return cmd
+}
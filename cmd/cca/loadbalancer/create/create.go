@@ -0,0 +1,121 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `load-balancer create` command
+package create
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name        string
+	publicIP    string
+	network     string
+	publicPort  int
+	privatePort int
+	protocol    string
+	algorithm   string
+	instances   []string
+}
+
+// NewCommand returns a new cobra.Command for load-balancer create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create load balancer rule",
+		Long:  "Create load balancer rule",
+		Example: `  cca load-balancer create -e dev --name web --public-ip 203.0.113.4 --network web \
+      --public-port 443 --private-port 8443 --instance web-1 --instance web-2`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			rule, err := flg.rule(resources)
+			if err != nil {
+				return err
+			}
+			created, err := resources.LoadBalancerRules.Create(*rule)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "load balancer rule name")
+	cmd.Flags().StringVar(&flg.publicIP, "public-ip", "", "public IP address or id")
+	cmd.Flags().StringVar(&flg.network, "network", "", "network name or id of the members")
+	cmd.Flags().IntVar(&flg.publicPort, "public-port", 0, "port of the public IP")
+	cmd.Flags().IntVar(&flg.privatePort, "private-port", 0, "port of the members")
+	cmd.Flags().StringVar(&flg.protocol, "protocol", "tcp", "protocol (tcp or udp)")
+	cmd.Flags().StringVar(&flg.algorithm, "algorithm", "roundrobin", "algorithm (roundrobin, leastconn or source)")
+	cmd.Flags().StringArrayVar(&flg.instances, "instance", []string{}, "instance name or id (repeatable)")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "public-ip", "network", "public-port", "private-port"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+// rule builds the load balancer rule to create from the flags,
+// looking up the ids of the referenced entities by their name if needed
+func (flg *flag) rule(resources cloudca.Resources) (*cloudca.LoadBalancerRule, error) {
+	ip, err := resolve.PublicIP(resources.PublicIps, flg.publicIP)
+	if err != nil {
+		return nil, err
+	}
+	network, err := resolve.Network(resources.Networks, flg.network)
+	if err != nil {
+		return nil, err
+	}
+	rule := &cloudca.LoadBalancerRule{
+		Name:        flg.name,
+		PublicIpId:  ip.Id,
+		NetworkId:   network.Id,
+		PublicPort:  strconv.Itoa(flg.publicPort),
+		PrivatePort: strconv.Itoa(flg.privatePort),
+		Protocol:    strings.ToLower(flg.protocol),
+		Algorithm:   strings.ToLower(flg.algorithm),
+	}
+	if len(flg.instances) > 0 {
+		instances, err := resolve.Instances(resources.Instances, flg.instances)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			rule.InstanceIds = append(rule.InstanceIds, instance.Id)
+		}
+	}
+	return rule, nil
+}
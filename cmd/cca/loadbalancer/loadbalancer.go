@@ -0,0 +1,58 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loadbalancer implements the `load-balancer` command
+package loadbalancer
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/addmember"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/create"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/delete"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/get"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/list"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/removemember"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/setmembers"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/stickiness"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/update"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for load-balancer
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"lb"},
+		Use:     "load-balancer",
+		Short:   "Manage load balancer rules of an environment",
+		Long: util.LongDescription(`
+            Load balancer rules distribute the traffic of a port of a public IP among the instances which
+            are members of the rule, optionally keeping clients on the same instance with a stickiness
+            policy. Rules and instances can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(addmember.NewCommand(cli))
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(removemember.NewCommand(cli))
+	cmd.AddCommand(setmembers.NewCommand(cli))
+	cmd.AddCommand(stickiness.NewCommand(cli))
+	cmd.AddCommand(update.NewCommand(cli))
+
+	return cmd
+}
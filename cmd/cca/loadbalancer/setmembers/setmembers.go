@@ -0,0 +1,82 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package setmembers implements the `load-balancer set-members` command
+package setmembers
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id        string
+	instances []string
+}
+
+// NewCommand returns a new cobra.Command for load-balancer set-members
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "set-members",
+		Short: "Set the instances of load balancer rule",
+		Long:  "Set the instances of load balancer rule, replacing the existing ones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			rule, err := resolve.LoadBalancerRule(resources.LoadBalancerRules, flg.id)
+			if err != nil {
+				return err
+			}
+			instances, err := resolve.Instances(resources.Instances, flg.instances)
+			if err != nil {
+				return err
+			}
+			ids := make([]string, len(instances))
+			for i, instance := range instances {
+				ids[i] = instance.Id
+			}
+			if err := resources.LoadBalancerRules.SetLoadBalancerRuleInstances(rule.Id, ids); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			rule, err = resources.LoadBalancerRules.Get(rule.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(rule)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "load balancer rule name or id")
+	cmd.Flags().StringArrayVar(&flg.instances, "instance", []string{}, "instance name or id (repeatable)")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "instance"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package set implements the `load-balancer stickiness set` command
+package set
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id     string
+	method string
+	params []string
+}
+
+// NewCommand returns a new cobra.Command for load-balancer stickiness set
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Use:     "set",
+		Short:   "Set stickiness policy of load balancer rule",
+		Long:    "Set stickiness policy of load balancer rule, replacing the existing one",
+		Example: `  cca load-balancer stickiness set -e dev --id web --method LbCookie --param cookieName=lb`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := flags.ParseKeyValues(flg.params)
+			if err != nil {
+				return err
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			rule, err := resolve.LoadBalancerRule(resources.LoadBalancerRules, flg.id)
+			if err != nil {
+				return err
+			}
+			if err := resources.LoadBalancerRules.SetLoadBalancerRuleStickinessPolicy(rule.Id, flg.method, params); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			rule, err = resources.LoadBalancerRules.Get(rule.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(rule)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "load balancer rule name or id")
+	cmd.Flags().StringVar(&flg.method, "method", "", "stickiness method (LbCookie, AppCookie or SourceBased)")
+	cmd.Flags().StringArrayVar(&flg.params, "param", []string{}, "parameter of the stickiness method in the form of key=value (repeatable)")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "method"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
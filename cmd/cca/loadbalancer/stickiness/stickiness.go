@@ -0,0 +1,38 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stickiness implements the `load-balancer stickiness` command
+package stickiness
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/stickiness/remove"
+	"github.com/cloud-ca/cca/cmd/cca/loadbalancer/stickiness/set"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for load-balancer stickiness
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "stickiness",
+		Short: "Manage stickiness policy of load balancer rules",
+		Long:  "Manage stickiness policy of load balancer rules, which keeps clients on the same instance",
+	}
+
+	cmd.AddCommand(remove.NewCommand(cli))
+	cmd.AddCommand(set.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package login implements the `login` command
+package login
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/client"
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/cloud-ca/cca/pkg/credentials"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for login
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "login",
+		Short: "Store the API key of the selected profile",
+		Long: util.LongDescription(`
+            Prompt for the API key of the selected profile, validate it against cloud.ca API and store it
+            in the encrypted credentials file (~/.cca/credentials). The stored key is used whenever it is
+            not provided with --api-key flag, CCA_API_KEY environment variable or the profile itself.
+            A plain text API key of the profile is removed from the configuration file, with a warning.
+
+            The API key can also be piped in, e.g. for non-interactive use:
+
+                $ echo "$API_KEY" | cca login --profile staging
+        `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile := cli.GlobalFlags.Profile
+			if err := config.ValidateProfileName(profile); err != nil {
+				return err
+			}
+			key, err := util.ReadSecret(fmt.Sprintf("API key for profile '%s': ", profile))
+			if err != nil {
+				return err
+			}
+			if key == "" {
+				return errors.New("API key cannot be empty")
+			}
+			ccaClient := client.NewClient(cli.GlobalFlags.APIURL, key, profile, cli.WaitFlags.TaskOptions())
+			if _, err := ccaClient.ServiceConnections.List(); err != nil {
+				return fmt.Errorf("cannot validate API key against %s: %s", cli.GlobalFlags.APIURL, err)
+			}
+			store, err := credentials.NewStore()
+			if err != nil {
+				return err
+			}
+			if err := store.Set(profile, key); err != nil {
+				return err
+			}
+			if err := removePlainKey(profile); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Logged in with profile '%s'\n", profile)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// removePlainKey removes the plain text API key of the profile from the
+// configuration file, as it would otherwise take precedence over the stored one
+func removePlainKey(profile string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	p, ok := cfg.Profiles[profile]
+	if !ok || p.APIKey == "" {
+		return nil
+	}
+	path, err := config.Path()
+	if err != nil {
+		return err
+	}
+	p.APIKey = ""
+	logrus.Warnf("Removing plain text API key of profile '%s' from %s, the stored one is used instead", profile, path)
+	return cfg.Write()
+}
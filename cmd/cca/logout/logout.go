@@ -0,0 +1,53 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logout implements the `logout` command
+package logout
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/credentials"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for logout
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "logout",
+		Short: "Remove the stored API key of the selected profile",
+		Long:  "Remove the API key of the selected profile from the encrypted credentials file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile := cli.GlobalFlags.Profile
+			store, err := credentials.NewStore()
+			if err != nil {
+				return err
+			}
+			deleted, err := store.Delete(profile)
+			if err != nil {
+				return err
+			}
+			if !deleted {
+				return fmt.Errorf("profile '%s' is not logged in", profile)
+			}
+			fmt.Fprintf(os.Stderr, "Logged out of profile '%s'\n", profile)
+			return nil
+		},
+	}
+
+	return cmd
+}
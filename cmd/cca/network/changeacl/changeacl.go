@@ -0,0 +1,78 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package changeacl implements the `network change-acl` command
+package changeacl
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id  string
+	acl string
+}
+
+// NewCommand returns a new cobra.Command for network change-acl
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "change-acl",
+		Short: "Change network ACL of network",
+		Long:  "Replace the network ACL filtering the traffic of network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			network, err := resolve.Network(resources.Networks, flg.id)
+			if err != nil {
+				return err
+			}
+			acl, err := resolve.NetworkACL(resources.NetworkAcls, flg.acl)
+			if err != nil {
+				return err
+			}
+			if _, err := resources.Networks.ChangeAcl(network.Id, acl.Id); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			network, err = resources.Networks.Get(network.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(network)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "network name or id")
+	cmd.Flags().StringVar(&flg.acl, "acl", "", "network ACL name or id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "acl"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
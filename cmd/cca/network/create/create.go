@@ -0,0 +1,107 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `network create` command
+package create
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name            string
+	description     string
+	vpc             string
+	networkOffering string
+	acl             string
+	cidr            string
+}
+
+// NewCommand returns a new cobra.Command for network create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create network",
+		Long:  "Create network in a VPC",
+		Example: `  cca network create -e dev --name web --vpc main \
+      --network-offering "Standard Tier" --acl default_allow --cidr 10.0.1.0/24`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			network, err := flg.network(resources)
+			if err != nil {
+				return err
+			}
+			created, err := resources.Networks.Create(*network, map[string]string{})
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "network name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "network description")
+	cmd.Flags().StringVar(&flg.vpc, "vpc", "", "VPC name or id")
+	cmd.Flags().StringVar(&flg.networkOffering, "network-offering", "", "network offering name or id")
+	cmd.Flags().StringVar(&flg.acl, "acl", "", "network ACL name or id")
+	cmd.Flags().StringVar(&flg.cidr, "cidr", "", "CIDR of the network, within the one of the VPC")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "vpc", "network-offering", "acl"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+// network builds the network to create from the flags, looking up
+// the ids of the referenced entities by their name if needed
+func (flg *flag) network(resources cloudca.Resources) (*cloudca.Network, error) {
+	vpc, err := resolve.Vpc(resources.Vpcs, flg.vpc)
+	if err != nil {
+		return nil, err
+	}
+	offering, err := resolve.NetworkOffering(resources.NetworkOfferings, flg.networkOffering)
+	if err != nil {
+		return nil, err
+	}
+	acl, err := resolve.NetworkACL(resources.NetworkAcls, flg.acl)
+	if err != nil {
+		return nil, err
+	}
+	return &cloudca.Network{
+		Name:              flg.name,
+		Description:       flg.description,
+		VpcId:             vpc.Id,
+		NetworkOfferingId: offering.Id,
+		NetworkAclId:      acl.Id,
+		Cidr:              flg.cidr,
+	}, nil
+}
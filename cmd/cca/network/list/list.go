@@ -0,0 +1,83 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `network list` command
+package list
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	vpc string
+}
+
+// NewCommand returns a new cobra.Command for network list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all networks",
+		Long:    "List all networks, optionally only the ones of a VPC",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			var networks []cloudca.Network
+			if flg.vpc == "" {
+				networks, err = resources.Networks.List()
+			} else {
+				networks, err = listOfVpc(resources, flg.vpc)
+			}
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(networks)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.vpc, "vpc", "", "VPC name or id the networks belong to")
+
+	return cmd
+}
+
+// listOfVpc lists the networks of the VPC. NetworkService.ListOfVpc of
+// go-cloudca sends the id of the VPC as the name of the query parameter,
+// so the option is provided explicitly and the result filtered as well.
+func listOfVpc(resources cloudca.Resources, nameOrID string) ([]cloudca.Network, error) {
+	vpc, err := resolve.Vpc(resources.Vpcs, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	networks, err := resources.Networks.ListWithOptions(map[string]string{"vpcId": vpc.Id})
+	if err != nil {
+		return nil, err
+	}
+	filtered := []cloudca.Network{}
+	for _, network := range networks {
+		if network.VpcId == vpc.Id {
+			filtered = append(filtered, network)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,51 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package network implements the `network` command
+package network
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/network/changeacl"
+	"github.com/cloud-ca/cca/cmd/cca/network/create"
+	"github.com/cloud-ca/cca/cmd/cca/network/delete"
+	"github.com/cloud-ca/cca/cmd/cca/network/get"
+	"github.com/cloud-ca/cca/cmd/cca/network/list"
+	"github.com/cloud-ca/cca/cmd/cca/network/update"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for network
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "network",
+		Short: "Manage networks of an environment",
+		Long: util.LongDescription(`
+            Networks are the tiers of a VPC which instances are connected to. The services available in a
+            network are defined by its network offering, and its traffic is filtered by its network ACL.
+            Networks, VPCs, offerings and ACLs can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(changeacl.NewCommand(cli))
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(update.NewCommand(cli))
+
+	return cmd
+}
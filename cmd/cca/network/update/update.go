@@ -0,0 +1,83 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package update implements the `network update` command
+package update
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id          string
+	name        string
+	description string
+}
+
+// NewCommand returns a new cobra.Command for network update
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "update",
+		Short: "Update network",
+		Long:  "Update name or description of network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			found, err := resolve.Network(resources.Networks, flg.id)
+			if err != nil {
+				return err
+			}
+			network := cloudca.Network{
+				Name:        found.Name,
+				Description: found.Description,
+			}
+			if cmd.Flags().Changed("name") {
+				network.Name = flg.name
+			}
+			if cmd.Flags().Changed("description") {
+				network.Description = flg.description
+			}
+			updated, err := resources.Networks.Update(found.Id, network)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(updated)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "network name or id")
+	cmd.Flags().StringVar(&flg.name, "name", "", "new network name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "new network description")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
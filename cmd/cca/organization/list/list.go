@@ -0,0 +1,56 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `organization list` command
+package list
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	filters []string
+}
+
+// NewCommand returns a new cobra.Command for organization list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all organizations",
+		Long:    "List all organizations, optionally filtered with one or more --filter key=value",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options, err := flags.ParseKeyValues(flg.filters)
+			if err != nil {
+				return err
+			}
+			organizations, err := cli.CcaClient.Organizations.ListWithOptions(options)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(organizations)
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&flg.filters, "filter", []string{}, "filter in the form of key=value (repeatable)")
+
+	return cmd
+}
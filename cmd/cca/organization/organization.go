@@ -0,0 +1,43 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package organization implements the `organization` command
+package organization
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/organization/get"
+	"github.com/cloud-ca/cca/cmd/cca/organization/list"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for organization
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"org"},
+		Use:     "organization",
+		Short:   "List and get organizations",
+		Long: util.LongDescription(`
+            Organizations hold the users and environments of a customer. Sub-organizations are
+            only visible to the users of their parent organizations.
+        `),
+	}
+
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+
+	return cmd
+}
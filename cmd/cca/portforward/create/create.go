@@ -0,0 +1,101 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `port-forward create` command
+package create
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/flags"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	rule string
+}
+
+// NewCommand returns a new cobra.Command for port-forward create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Use:     "create",
+		Short:   "Create port forwarding rule",
+		Long:    "Create port forwarding rule, in the form of '" + flags.PortForwardSyntax + "'",
+		Example: `  cca port-forward create -e dev --rule "203.0.113.4:2222->web-1:22/tcp"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parsed, err := flags.ParsePortForward(flg.rule)
+			if err != nil {
+				return err
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			rule, err := portForwardingRule(resources, parsed)
+			if err != nil {
+				return err
+			}
+			created, err := resources.PortForwardingRules.Create(*rule)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.rule, "rule", "", "rule in the shorthand syntax")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("rule")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// portForwardingRule builds the rule to create, looking up the
+// public IP and the private IP of the instance it forwards to
+func portForwardingRule(resources cloudca.Resources, parsed *flags.PortForward) (*cloudca.PortForwardingRule, error) {
+	ip, err := resolve.PublicIP(resources.PublicIps, parsed.PublicIP)
+	if err != nil {
+		return nil, err
+	}
+	found, err := resolve.Instance(resources.Instances, parsed.Instance)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := resources.Instances.Get(found.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &cloudca.PortForwardingRule{
+		PublicIpId:       ip.Id,
+		PublicPortStart:  parsed.PublicPortStart,
+		PublicPortEnd:    parsed.PublicPortEnd,
+		PrivateIpId:      instance.IpAddressId,
+		PrivatePortStart: parsed.PrivatePortStart,
+		PrivatePortEnd:   parsed.PrivatePortEnd,
+		Protocol:         parsed.Protocol,
+	}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `port-forward list` command
+package list
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	instance string
+	publicIP string
+}
+
+// NewCommand returns a new cobra.Command for port-forward list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all port forwarding rules",
+		Long:    "List all port forwarding rules, optionally only the ones of an instance or public IP",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			rules, err := resources.PortForwardingRules.List()
+			if err != nil {
+				return err
+			}
+			if flg.instance != "" {
+				instance, err := resolve.Instance(resources.Instances, flg.instance)
+				if err != nil {
+					return err
+				}
+				rules = filter(rules, func(rule cloudca.PortForwardingRule) bool {
+					return rule.InstanceId == instance.Id
+				})
+			}
+			if flg.publicIP != "" {
+				ip, err := resolve.PublicIP(resources.PublicIps, flg.publicIP)
+				if err != nil {
+					return err
+				}
+				rules = filter(rules, func(rule cloudca.PortForwardingRule) bool {
+					return rule.PublicIpId == ip.Id
+				})
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(rules)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.instance, "instance", "", "instance name or id the rules forward to")
+	cmd.Flags().StringVar(&flg.publicIP, "public-ip", "", "public IP address or id the rules forward from")
+
+	return cmd
+}
+
+// filter returns the rules matching fn
+func filter(rules []cloudca.PortForwardingRule, fn func(rule cloudca.PortForwardingRule) bool) []cloudca.PortForwardingRule {
+	filtered := []cloudca.PortForwardingRule{}
+	for _, rule := range rules {
+		if fn(rule) {
+			filtered = append(filtered, rule)
+		}
+	}
+	return filtered
+}
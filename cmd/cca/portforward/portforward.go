@@ -0,0 +1,48 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package portforward implements the `port-forward` command
+package portforward
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/portforward/create"
+	"github.com/cloud-ca/cca/cmd/cca/portforward/delete"
+	"github.com/cloud-ca/cca/cmd/cca/portforward/get"
+	"github.com/cloud-ca/cca/cmd/cca/portforward/list"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for port-forward
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"pf"},
+		Use:     "port-forward",
+		Short:   "Manage port forwarding rules of an environment",
+		Long: util.LongDescription(`
+            Port forwarding rules forward the traffic of ports of a public IP to ports of an instance of
+            the same VPC. Public IPs can be referred to by either their address or id, and instances by
+            either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package acquire implements the `public-ip acquire` command
+package acquire
+
+import (
+	"errors"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	vpc     string
+	network string
+}
+
+// NewCommand returns a new cobra.Command for public-ip acquire
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "acquire",
+		Short: "Acquire public IP",
+		Long:  "Acquire public IP for a VPC, or the VPC of a network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (flg.vpc == "") == (flg.network == "") {
+				return errors.New("exactly one of --vpc or --network must be provided")
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			ip := cloudca.PublicIp{}
+			if flg.network != "" {
+				network, err := resolve.Network(resources.Networks, flg.network)
+				if err != nil {
+					return err
+				}
+				ip.NetworkId = network.Id
+				ip.VpcId = network.VpcId
+			} else {
+				vpc, err := resolve.Vpc(resources.Vpcs, flg.vpc)
+				if err != nil {
+					return err
+				}
+				ip.VpcId = vpc.Id
+			}
+			acquired, err := resources.PublicIps.Acquire(ip)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(acquired)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.vpc, "vpc", "", "VPC name or id")
+	cmd.Flags().StringVar(&flg.network, "network", "", "network name or id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package enablestaticnat implements the `public-ip enable-static-nat` command
+package enablestaticnat
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id       string
+	instance string
+}
+
+// NewCommand returns a new cobra.Command for public-ip enable-static-nat
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "enable-static-nat",
+		Short: "Enable static NAT of public IP to instance",
+		Long:  "Enable static NAT of public IP, forwarding all its traffic to the private IP of instance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			ip, err := resolve.PublicIP(resources.PublicIps, flg.id)
+			if err != nil {
+				return err
+			}
+			found, err := resolve.Instance(resources.Instances, flg.instance)
+			if err != nil {
+				return err
+			}
+			instance, err := resources.Instances.Get(found.Id)
+			if err != nil {
+				return err
+			}
+			nat := cloudca.PublicIp{
+				Id:          ip.Id,
+				PrivateIpId: instance.IpAddressId,
+			}
+			if _, err := resources.PublicIps.EnableStaticNat(nat); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			ip, err = resources.PublicIps.Get(ip.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(ip)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "public IP address or id")
+	cmd.Flags().StringVar(&flg.instance, "instance", "", "instance name or id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "instance"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publicip implements the `public-ip` command
+package publicip
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/publicip/acquire"
+	"github.com/cloud-ca/cca/cmd/cca/publicip/disablestaticnat"
+	"github.com/cloud-ca/cca/cmd/cca/publicip/enablestaticnat"
+	"github.com/cloud-ca/cca/cmd/cca/publicip/get"
+	"github.com/cloud-ca/cca/cmd/cca/publicip/list"
+	"github.com/cloud-ca/cca/cmd/cca/publicip/release"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for public-ip
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ip"},
+		Use:     "public-ip",
+		Short:   "Manage public IPs of an environment",
+		Long: util.LongDescription(`
+            Public IPs are acquired for a VPC and expose its instances to the internet, either through
+            static NAT to a single instance, port forwarding rules or load balancer rules. Public IPs can
+            be referred to by either their address or id.
+        `),
+	}
+
+	cmd.AddCommand(acquire.NewCommand(cli))
+	cmd.AddCommand(disablestaticnat.NewCommand(cli))
+	cmd.AddCommand(enablestaticnat.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(release.NewCommand(cli))
+
+	return cmd
+}
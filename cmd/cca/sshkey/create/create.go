@@ -0,0 +1,185 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `ssh-key create` command
+package create
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/sshkey"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name           string
+	fromFile       string
+	generate       bool
+	privateKeyFile string
+}
+
+// NewCommand returns a new cobra.Command for ssh-key create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create SSH key",
+		Long: util.LongDescription(`
+            Create SSH key, either from an existing public key file which is validated locally, or from a
+            newly generated RSA keypair whose private key is written with 0600 permissions.
+        `),
+		Example: `  cca ssh-key create -e dev --name alice --from-file ~/.ssh/id_ed25519.pub
+  cca ssh-key create -e dev --name deployer --generate --private-key-file ~/.ssh/deployer`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (flg.fromFile == "") == !flg.generate {
+				return errors.New("exactly one of --from-file or --generate must be provided")
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			var created *cloudca.SSHKey
+			if flg.generate {
+				created, err = flg.createGenerated(resources)
+			} else {
+				created, err = flg.createFromFile(resources)
+			}
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "SSH key name")
+	cmd.Flags().StringVar(&flg.fromFile, "from-file", "", "path to the public key file to import")
+	cmd.Flags().BoolVar(&flg.generate, "generate", false, "generate a new keypair")
+	cmd.Flags().StringVar(&flg.privateKeyFile, "private-key-file", "", "path to write the generated private key to, and the public key with .pub suffix (default ~/.ssh/cca_<name>)")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("name")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// createFromFile creates the SSH key from the validated public key file
+func (flg *flag) createFromFile(resources cloudca.Resources) (*cloudca.SSHKey, error) {
+	data, err := ioutil.ReadFile(flg.fromFile)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := sshkey.ParsePublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", flg.fromFile, err)
+	}
+	return resources.SSHKeys.Create(cloudca.SSHKey{Name: flg.name, PublicKey: publicKey})
+}
+
+// createGenerated generates a keypair, writes it next to each other like
+// ssh-keygen does and creates the SSH key from its public key. The key
+// files are removed if the SSH key cannot be created.
+func (flg *flag) createGenerated(resources cloudca.Resources) (*cloudca.SSHKey, error) {
+	path := flg.privateKeyFile
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, ".ssh", "cca_"+flg.name)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	// the key files are created exclusively before generating the keypair,
+	// so that existing keys are never replaced and are reported right away
+	privateFile, err := createExclusive(path, 0600)
+	if err != nil {
+		return nil, err
+	}
+	publicFile, err := createExclusive(path+".pub", 0644)
+	if err != nil {
+		privateFile.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	remove := func() {
+		privateFile.Close()
+		publicFile.Close()
+		os.Remove(path)
+		os.Remove(path + ".pub")
+	}
+
+	privateKey, publicKey, err := sshkey.Generate(sshkey.DefaultBits, flg.name)
+	if err != nil {
+		remove()
+		return nil, err
+	}
+	if err := writeAndClose(privateFile, privateKey); err != nil {
+		remove()
+		return nil, err
+	}
+	if err := writeAndClose(publicFile, []byte(publicKey+"\n")); err != nil {
+		remove()
+		return nil, err
+	}
+
+	created, err := resources.SSHKeys.Create(cloudca.SSHKey{Name: flg.name, PublicKey: publicKey})
+	if err != nil {
+		remove()
+		return nil, err
+	}
+	fingerprint, err := sshkey.Fingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "Private key written to %s (fingerprint %s)\n", path, fingerprint)
+	return created, nil
+}
+
+// createExclusive creates the file 'name', which must not exist yet
+func createExclusive(name string, perm os.FileMode) (*os.File, error) {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if os.IsExist(err) {
+		return nil, fmt.Errorf("file '%s' already exists", name)
+	}
+	return file, err
+}
+
+// writeAndClose writes data to the file and closes it, reporting the
+// errors of both as the data may only be flushed on close
+func writeAndClose(file *os.File, data []byte) error {
+	_, err := file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sshkey implements the `ssh-key` command
+package sshkey
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/sshkey/create"
+	"github.com/cloud-ca/cca/cmd/cca/sshkey/delete"
+	"github.com/cloud-ca/cca/cmd/cca/sshkey/get"
+	"github.com/cloud-ca/cca/cmd/cca/sshkey/list"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for ssh-key
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "ssh-key",
+		Short: "Manage SSH keys of an environment",
+		Long: util.LongDescription(`
+            SSH keys are public keys registered in an environment, which can be associated to instances
+            to log in with the matching private key. SSH keys can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package task implements the `task` command
+package task
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/task/get"
+	"github.com/cloud-ca/cca/cmd/cca/task/wait"
+	"github.com/cloud-ca/cca/cmd/cca/task/watch"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for task
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "task",
+		Short: "Inspect and wait for asynchronous tasks",
+		Long: util.LongDescription(`
+            Mutating operations of cloud.ca are asynchronous tasks. Commands run with --no-wait print the
+            task instead of waiting for it, which can then be inspected, waited for or watched later on.
+        `),
+	}
+
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(wait.NewCommand(cli))
+	cmd.AddCommand(watch.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wait implements the `task wait` command
+package wait
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for task wait
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "wait <id>",
+		Short: "Wait for a task to complete",
+		Long: util.LongDescription(`
+            Wait for a task to complete and print it. The exit code is 0 if the task succeeded, 2 if
+            it failed and 3 if it is still not completed after the timeout.
+        `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			task, err := cli.CcaClient.WaitTask(args[0])
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(task)
+			})
+		},
+	}
+
+	cli.WaitFlags.AddTimeoutFlags(cmd)
+
+	return cmd
+}
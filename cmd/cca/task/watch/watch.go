@@ -0,0 +1,55 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch implements the `task watch` command
+package watch
+
+import (
+	"fmt"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/client"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for task watch
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.ExactArgs(1),
+		Use:   "watch <id>",
+		Short: "Watch the status changes of a task",
+		Long: util.LongDescription(`
+            Watch a task until it is completed, printing it every time its status changes. The exit
+            code is the same as the one of 'cca task wait'.
+        `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// every status change would replace the file written by the previous one
+			if cli.GlobalFlags.OutputFile != "" {
+				return fmt.Errorf("--output-file is not supported by 'cca task watch', use 'cca task wait' instead")
+			}
+			_, err := cli.CcaClient.WatchTask(args[0], func(task *client.Task) error {
+				return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+					return formatter.Format(task)
+				})
+			})
+			return err
+		},
+	}
+
+	cli.WaitFlags.AddTimeoutFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,147 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `template create` command
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name            string
+	description     string
+	url             string
+	format          string
+	hypervisor      string
+	osType          string
+	zone            string
+	sshKeyEnabled   bool
+	passwordEnabled bool
+	waitReady       bool
+}
+
+// NewCommand returns a new cobra.Command for template create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Register private template from URL",
+		Long:  "Register private template from the URL of an image, optionally waiting for it to be downloaded and ready",
+		Example: `  cca template create -e dev --name "app 2019-11-20" --url https://images.example.com/app.qcow2 \
+      --format QCOW2 --hypervisor KVM --os-type "Ubuntu 18.04 (64-bit)" --wait-ready`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flg.waitReady && !cli.WaitFlags.TaskOptions().Wait {
+				return errors.New("--wait-ready cannot be used with --no-wait or --wait=false")
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			template, err := flg.template(resources)
+			if err != nil {
+				return err
+			}
+			created, err := resources.Templates.Create(*template)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			if flg.waitReady && !created.Ready {
+				created, err = waitReady(cli, resources, created)
+				if err != nil {
+					return err
+				}
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "template name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "template description (default name)")
+	cmd.Flags().StringVar(&flg.url, "url", "", "URL of the image to download")
+	cmd.Flags().StringVar(&flg.format, "format", "QCOW2", "format of the image (e.g. QCOW2, RAW, VHD, OVA)")
+	cmd.Flags().StringVar(&flg.hypervisor, "hypervisor", "KVM", "hypervisor of the image (e.g. KVM, XenServer, VMware)")
+	cmd.Flags().StringVar(&flg.osType, "os-type", "", "OS type id, or name of the OS type of an existing template")
+	cmd.Flags().StringVar(&flg.zone, "zone", "", "zone name or id (default all zones)")
+	cmd.Flags().BoolVar(&flg.sshKeyEnabled, "ssh-key-enabled", true, "whether the image supports SSH keys")
+	cmd.Flags().BoolVar(&flg.passwordEnabled, "password-enabled", false, "whether the image supports password reset")
+	cmd.Flags().BoolVar(&flg.waitReady, "wait-ready", false, "wait for the template to be downloaded and ready, within --timeout")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "url", "os-type"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+// template builds the template to create from the flags, looking up
+// the ids of the referenced entities by their name if needed
+func (flg *flag) template(resources cloudca.Resources) (*cloudca.Template, error) {
+	osTypeID, err := resolve.OSTypeID(resources.Templates, flg.osType)
+	if err != nil {
+		return nil, err
+	}
+	template := &cloudca.Template{
+		Name:             flg.name,
+		Description:      flg.description,
+		URL:              flg.url,
+		Format:           strings.ToUpper(flg.format),
+		Hypervisor:       flg.hypervisor,
+		OSTypeID:         osTypeID,
+		SSHKeyEnabled:    flg.sshKeyEnabled,
+		PassowordEnabled: flg.passwordEnabled,
+	}
+	if template.Description == "" {
+		template.Description = flg.name
+	}
+	if flg.zone != "" {
+		zone, err := resolve.Zone(resources.Zones, flg.zone)
+		if err != nil {
+			return nil, err
+		}
+		template.ZoneID = zone.Id
+	}
+	return template, nil
+}
+
+// waitReady polls the template until it is downloaded and ready
+func waitReady(cli *cli.Wrapper, resources cloudca.Resources, template *cloudca.Template) (*cloudca.Template, error) {
+	id := template.ID
+	err := cli.CcaClient.WaitUntil(fmt.Sprintf("template %s to be ready", template.Name), func() (bool, error) {
+		var err error
+		template, err = resources.Templates.Get(id)
+		if err != nil {
+			return false, err
+		}
+		return template.Ready, nil
+	})
+	return template, err
+}
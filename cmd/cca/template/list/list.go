@@ -0,0 +1,111 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `template list` command
+package list
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	osType  string
+	zone    string
+	public  bool
+	private bool
+	ready   bool
+}
+
+// NewCommand returns a new cobra.Command for template list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all templates",
+		Long:    "List all templates, optionally filtered by OS type, zone, visibility or readiness",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flg.public && flg.private {
+				return errors.New("only one of --public or --private can be provided")
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			templates, err := resources.Templates.List()
+			if err != nil {
+				return err
+			}
+			zoneID := ""
+			if flg.zone != "" {
+				zone, err := resolve.Zone(resources.Zones, flg.zone)
+				if err != nil {
+					return err
+				}
+				zoneID = zone.Id
+			}
+			filtered := []cloudca.Template{}
+			for _, template := range templates {
+				if flg.matches(template, zoneID) {
+					filtered = append(filtered, template)
+				}
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(filtered)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.osType, "os-type", "", "only templates whose OS type contains this text, case insensitive")
+	cmd.Flags().StringVar(&flg.zone, "zone", "", "only templates available in this zone name or id")
+	cmd.Flags().BoolVar(&flg.public, "public", false, "only public templates")
+	cmd.Flags().BoolVar(&flg.private, "private", false, "only private templates")
+	cmd.Flags().BoolVar(&flg.ready, "ready", false, "only templates ready to create instances from")
+
+	return cmd
+}
+
+// matches returns whether the template matches all the filters
+func (flg *flag) matches(template cloudca.Template, zoneID string) bool {
+	if flg.osType != "" && !strings.Contains(strings.ToLower(template.OSType), strings.ToLower(flg.osType)) {
+		return false
+	}
+	if flg.public && !template.AvailablePublicly || flg.private && template.AvailablePublicly {
+		return false
+	}
+	if flg.ready && !template.Ready {
+		return false
+	}
+	if zoneID != "" && template.ZoneID != zoneID && !contains(template.AvailableInZones, zoneID) {
+		return false
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
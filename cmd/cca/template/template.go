@@ -0,0 +1,47 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package template implements the `template` command
+package template
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/template/create"
+	"github.com/cloud-ca/cca/cmd/cca/template/delete"
+	"github.com/cloud-ca/cca/cmd/cca/template/get"
+	"github.com/cloud-ca/cca/cmd/cca/template/list"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for template
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "template",
+		Short: "Manage templates of an environment",
+		Long: util.LongDescription(`
+            Templates are the images instances are created from. Besides the public templates, private
+            templates can be registered from the URL of an image, which is then downloaded until the
+            template is ready. Templates can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package attach implements the `volume attach` command
+package attach
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id       string
+	instance string
+}
+
+// NewCommand returns a new cobra.Command for volume attach
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "attach",
+		Short: "Attach data volume to instance",
+		Long:  "Attach data volume to instance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			volume, err := resolve.Volume(resources.Volumes, flg.id)
+			if err != nil {
+				return err
+			}
+			instance, err := resolve.Instance(resources.Instances, flg.instance)
+			if err != nil {
+				return err
+			}
+			if err := resources.Volumes.AttachToInstance(volume, instance.Id); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			volume, err = resources.Volumes.Get(volume.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(volume)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "volume name or id")
+	cmd.Flags().StringVar(&flg.instance, "instance", "", "instance name or id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"id", "instance"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
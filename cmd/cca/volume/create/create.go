@@ -0,0 +1,91 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `volume create` command
+package create
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name         string
+	diskOffering string
+	size         int
+	iops         int
+	instance     string
+}
+
+// NewCommand returns a new cobra.Command for volume create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create data volume",
+		Long:  "Create data volume, optionally attached to an instance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			offering, err := resolve.DiskOffering(resources.DiskOfferings, flg.diskOffering)
+			if err != nil {
+				return err
+			}
+			volume := cloudca.Volume{
+				Name:           flg.name,
+				DiskOfferingId: offering.Id,
+				GbSize:         flg.size,
+				Iops:           flg.iops,
+			}
+			if flg.instance != "" {
+				instance, err := resolve.Instance(resources.Instances, flg.instance)
+				if err != nil {
+					return err
+				}
+				volume.InstanceId = instance.Id
+			}
+			created, err := resources.Volumes.Create(volume)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "volume name")
+	cmd.Flags().StringVar(&flg.diskOffering, "disk-offering", "", "disk offering name or id")
+	cmd.Flags().IntVar(&flg.size, "size", 0, "size in GB, for custom size disk offering")
+	cmd.Flags().IntVar(&flg.iops, "iops", 0, "IOPS, for custom IOPS disk offering")
+	cmd.Flags().StringVar(&flg.instance, "instance", "", "instance name or id to attach the volume to")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "disk-offering"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
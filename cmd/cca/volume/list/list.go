@@ -0,0 +1,83 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list implements the `volume list` command
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	volumeType string
+	instance   string
+}
+
+// NewCommand returns a new cobra.Command for volume list
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		Use:     "list",
+		Short:   "List all volumes",
+		Long:    "List all volumes, optionally only the ones of a type or attached to an instance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			var volumes []cloudca.Volume
+			switch strings.ToUpper(flg.volumeType) {
+			case "":
+				volumes, err = resources.Volumes.List()
+			case cloudca.VOLUME_TYPE_OS, cloudca.VOLUME_TYPE_DATA:
+				volumes, err = resources.Volumes.ListOfType(strings.ToUpper(flg.volumeType))
+			default:
+				return fmt.Errorf("invalid volume type '%s', must be one of %s or %s", flg.volumeType, cloudca.VOLUME_TYPE_OS, cloudca.VOLUME_TYPE_DATA)
+			}
+			if err != nil {
+				return err
+			}
+			if flg.instance != "" {
+				instance, err := resolve.Instance(resources.Instances, flg.instance)
+				if err != nil {
+					return err
+				}
+				attached := []cloudca.Volume{}
+				for _, volume := range volumes {
+					if volume.InstanceId == instance.Id {
+						attached = append(attached, volume)
+					}
+				}
+				volumes = attached
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(volumes)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.volumeType, "type", "", "volume type (OS or DATA)")
+	cmd.Flags().StringVar(&flg.instance, "instance", "", "instance name or id the volumes are attached to")
+
+	return cmd
+}
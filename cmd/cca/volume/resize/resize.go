@@ -0,0 +1,96 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resize implements the `volume resize` command
+package resize
+
+import (
+	"errors"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id           string
+	diskOffering string
+	size         int
+	iops         int
+}
+
+// NewCommand returns a new cobra.Command for volume resize
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "resize",
+		Short: "Resize volume",
+		Long:  "Resize volume, either to another disk offering or to a new size and IOPS for custom offerings",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("size") && !cmd.Flags().Changed("iops") && !cmd.Flags().Changed("disk-offering") {
+				return errors.New("at least one of --size, --iops or --disk-offering must be provided")
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			volume, err := resolve.Volume(resources.Volumes, flg.id)
+			if err != nil {
+				return err
+			}
+			resize := &cloudca.Volume{
+				Id:             volume.Id,
+				DiskOfferingId: volume.DiskOfferingId,
+				GbSize:         flg.size,
+				Iops:           flg.iops,
+			}
+			if flg.diskOffering != "" {
+				offering, err := resolve.DiskOffering(resources.DiskOfferings, flg.diskOffering)
+				if err != nil {
+					return err
+				}
+				resize.DiskOfferingId = offering.Id
+			}
+			if err := resources.Volumes.Resize(resize); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			volume, err = resources.Volumes.Get(volume.Id)
+			if err != nil {
+				return err
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(volume)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "volume name or id")
+	cmd.Flags().StringVar(&flg.diskOffering, "disk-offering", "", "disk offering name or id (default current offering)")
+	cmd.Flags().IntVar(&flg.size, "size", 0, "new size in GB, for custom size disk offering")
+	cmd.Flags().IntVar(&flg.iops, "iops", 0, "new IOPS, for custom IOPS disk offering")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package volume implements the `volume` command
+package volume
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/volume/attach"
+	"github.com/cloud-ca/cca/cmd/cca/volume/create"
+	"github.com/cloud-ca/cca/cmd/cca/volume/delete"
+	"github.com/cloud-ca/cca/cmd/cca/volume/detach"
+	"github.com/cloud-ca/cca/cmd/cca/volume/get"
+	"github.com/cloud-ca/cca/cmd/cca/volume/list"
+	"github.com/cloud-ca/cca/cmd/cca/volume/resize"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for volume
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "volume",
+		Short: "Manage volumes of an environment",
+		Long: util.LongDescription(`
+            Volumes are the disks of the instances. Each instance has an OS volume created from its
+            template, and DATA volumes can be created from a disk offering, then attached to and detached
+            from instances. Volumes and instances can be referred to by either their name or id.
+        `),
+	}
+
+	cmd.AddCommand(attach.NewCommand(cli))
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(delete.NewCommand(cli))
+	cmd.AddCommand(detach.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(resize.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package create implements the `vpc create` command
+package create
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	name          string
+	description   string
+	vpcOffering   string
+	zone          string
+	networkDomain string
+}
+
+// NewCommand returns a new cobra.Command for vpc create
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "create",
+		Short: "Create VPC",
+		Long:  "Create VPC",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			offering, err := resolve.VpcOffering(resources.VpcOfferings, flg.vpcOffering)
+			if err != nil {
+				return err
+			}
+			vpc := cloudca.Vpc{
+				Name:          flg.name,
+				Description:   flg.description,
+				VpcOfferingId: offering.Id,
+				NetworkDomain: flg.networkDomain,
+			}
+			if flg.zone != "" {
+				zone, err := resolve.Zone(resources.Zones, flg.zone)
+				if err != nil {
+					return err
+				}
+				vpc.ZoneId = zone.Id
+			}
+			created, err := resources.Vpcs.Create(vpc)
+			if err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.name, "name", "", "VPC name")
+	cmd.Flags().StringVar(&flg.description, "description", "", "VPC description")
+	cmd.Flags().StringVar(&flg.vpcOffering, "vpc-offering", "", "VPC offering name or id")
+	cmd.Flags().StringVar(&flg.zone, "zone", "", "zone name or id (default zone of the environment)")
+	cmd.Flags().StringVar(&flg.networkDomain, "network-domain", "", "DNS domain of the networks of the VPC")
+	cli.WaitFlags.AddFlags(cmd)
+
+	for _, name := range []string{"name", "vpc-offering"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vpc implements the `vpc` command
+package vpc
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/vpc/create"
+	"github.com/cloud-ca/cca/cmd/cca/vpc/destroy"
+	"github.com/cloud-ca/cca/cmd/cca/vpc/get"
+	"github.com/cloud-ca/cca/cmd/cca/vpc/list"
+	"github.com/cloud-ca/cca/cmd/cca/vpc/restartrouter"
+	"github.com/cloud-ca/cca/cmd/cca/vpc/update"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for vpc
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "vpc",
+		Short: "Manage VPCs of an environment",
+		Long: util.LongDescription(`
+            VPCs (Virtual Private Clouds) are isolated parts of the cloud, with a virtual router providing
+            the source NAT, VPN and load balancing to their networks. VPCs can be referred to by either
+            their name or id.
+        `),
+	}
+
+	cmd.AddCommand(create.NewCommand(cli))
+	cmd.AddCommand(destroy.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(restartrouter.NewCommand(cli))
+	cmd.AddCommand(update.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enable
+
+import (
+	"fmt"
+
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+// networkManagerProfile returns a keyfile connection profile for the L2TP
+// plugin of NetworkManager. The credentials of the VPN user are left out so
+// NetworkManager asks for them on every connection.
+func networkManagerProfile(vpn *cloudca.RemoteAccessVpn) string {
+	return fmt.Sprintf(`[connection]
+id=cca %[1]s
+type=vpn
+autoconnect=false
+
+[vpn]
+service-type=org.freedesktop.NetworkManager.l2tp
+gateway=%[1]s
+ipsec-enabled=yes
+ipsec-psk-flags=0
+password-flags=2
+
+[vpn-secrets]
+ipsec-psk=%[2]s
+
+[ipv4]
+method=auto
+never-default=true
+`, vpn.PublicIpAddress, vpn.PresharedKey)
+}
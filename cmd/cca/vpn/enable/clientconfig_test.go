@@ -0,0 +1,57 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enable
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+func TestNetworkManagerProfile(t *testing.T) {
+	vpn := &cloudca.RemoteAccessVpn{
+		PublicIpAddress: "203.0.113.4",
+		PresharedKey:    "s3cr3t",
+	}
+	expected := `[connection]
+id=cca 203.0.113.4
+type=vpn
+autoconnect=false
+
+[vpn]
+service-type=org.freedesktop.NetworkManager.l2tp
+gateway=203.0.113.4
+ipsec-enabled=yes
+ipsec-psk-flags=0
+password-flags=2
+
+[vpn-secrets]
+ipsec-psk=s3cr3t
+
+[ipv4]
+method=auto
+never-default=true
+`
+	profile := networkManagerProfile(vpn)
+	if profile != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, profile)
+	}
+	for _, secret := range []string{"user=", "password="} {
+		if strings.Contains(profile, "\n"+secret) {
+			t.Errorf("profile must not contain the VPN user credentials, found %q", secret)
+		}
+	}
+}
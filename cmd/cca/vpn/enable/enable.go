@@ -0,0 +1,88 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package enable implements the `vpn enable` command
+package enable
+
+import (
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	id           string
+	clientConfig bool
+}
+
+// NewCommand returns a new cobra.Command for vpn enable
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "enable",
+		Short: "Enable remote access VPN",
+		Long: util.LongDescription(`
+            Enable remote access VPN and output it along with the public IP address and pre-shared
+            key needed to configure a client. With --client-config, a NetworkManager connection
+            profile is output instead, which can be imported with:
+
+                cca vpn enable --id 203.0.113.4 --client-config --output-file cca.nmconnection
+                nmcli connection load cca.nmconnection
+
+            NetworkManager prompts for the username and password of a VPN user when connecting.
+        `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			found, err := resolve.RemoteAccessVpn(resources.RemoteAccessVpn, flg.id)
+			if err != nil {
+				return err
+			}
+			if _, err := resources.RemoteAccessVpn.Enable(found.Id); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			vpn, err := resources.RemoteAccessVpn.Get(found.Id)
+			if err != nil {
+				return err
+			}
+			if flg.clientConfig {
+				return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+					return formatter.Text(networkManagerProfile(vpn))
+				})
+			}
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.FormatColumns(vpn, "id", "publicIpAddress", "state", "type", "presharedKey")
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.id, "id", "", "VPN public IP address or id")
+	cmd.Flags().BoolVar(&flg.clientConfig, "client-config", false, "output a NetworkManager L2TP/IPsec connection profile instead of the VPN")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("id")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
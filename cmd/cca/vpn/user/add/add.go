@@ -0,0 +1,115 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package add implements the `vpn user add` command
+package add
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+	"github.com/spf13/cobra"
+)
+
+// passwordLength is the length of generated passwords
+const passwordLength = 20
+
+// passwordAlphabet avoids the characters which are easily confused or need
+// quoting when the password is pasted in a VPN client
+const passwordAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+type flag struct {
+	username string
+	password string
+}
+
+// NewCommand returns a new cobra.Command for vpn user add
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "add",
+		Short: "Add VPN user",
+		Long: util.LongDescription(`
+            Add VPN user and output it along with its password, which the API never returns
+            afterwards. A random password is generated if none is provided, which requires
+            waiting for the user to be added in order to output it.
+        `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flg.password == "" && !cli.WaitFlags.TaskOptions().Wait {
+				return errors.New("--password must be provided with --no-wait or --wait=false, the generated password would never be printed")
+			}
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			password := flg.password
+			if password == "" {
+				password, err = generatePassword()
+				if err != nil {
+					return err
+				}
+			}
+			user := cloudca.RemoteAccessVpnUser{
+				Username: flg.username,
+				Password: password,
+			}
+			if _, err := resources.RemoteAccessVpnUser.Create(user); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			created, err := resolve.RemoteAccessVpnUser(resources.RemoteAccessVpnUser, flg.username)
+			if err != nil {
+				return err
+			}
+			created.Password = password
+			return cli.OutputBuilder.Build(func(formatter *output.Formatter) error {
+				return formatter.Format(created)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.username, "username", "", "username of the VPN user")
+	cmd.Flags().StringVar(&flg.password, "password", "", "password of the VPN user (default generated)")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("username")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// generatePassword returns a random password of passwordLength characters
+func generatePassword() (string, error) {
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	b := make([]byte, passwordLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = passwordAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
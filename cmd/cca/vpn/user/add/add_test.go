@@ -0,0 +1,50 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package add
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		password, err := generatePassword()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(password) != passwordLength {
+			t.Errorf("expected %d characters, got %q", passwordLength, password)
+		}
+		for _, c := range password {
+			if !strings.ContainsRune(passwordAlphabet, c) {
+				t.Errorf("unexpected character %q in %q", c, password)
+			}
+		}
+		if seen[password] {
+			t.Errorf("password %q generated twice", password)
+		}
+		seen[password] = true
+	}
+}
+
+func TestPasswordAlphabet(t *testing.T) {
+	for _, c := range "0O1lI" {
+		if strings.ContainsRune(passwordAlphabet, c) {
+			t.Errorf("alphabet contains easily confused character %q", c)
+		}
+	}
+}
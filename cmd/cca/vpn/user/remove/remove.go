@@ -0,0 +1,68 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remove implements the `vpn user remove` command
+package remove
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/spf13/cobra"
+)
+
+type flag struct {
+	username string
+}
+
+// NewCommand returns a new cobra.Command for vpn user remove
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	flg := &flag{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "remove",
+		Short: "Remove VPN user",
+		Long:  "Remove VPN user, revoking their access to all the VPNs of the environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resources, err := cli.Resources()
+			if err != nil {
+				return err
+			}
+			user, err := resolve.RemoteAccessVpnUser(resources.RemoteAccessVpnUser, flg.username)
+			if err != nil {
+				return err
+			}
+			if _, err := resources.RemoteAccessVpnUser.Delete(*user); err != nil {
+				return err
+			}
+			if cli.HasPendingTasks() {
+				return cli.FormatPendingTasks()
+			}
+			fmt.Fprintf(os.Stderr, "VPN user '%s' removed\n", user.Username)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flg.username, "username", "", "VPN user username or id")
+	cli.WaitFlags.AddFlags(cmd)
+
+	err := cmd.MarkFlagRequired("username")
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package user implements the `vpn user` command
+package user
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/vpn/user/add"
+	"github.com/cloud-ca/cca/cmd/cca/vpn/user/list"
+	"github.com/cloud-ca/cca/cmd/cca/vpn/user/remove"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for vpn user
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "user",
+		Short: "Manage VPN users of an environment",
+		Long:  "Manage VPN users of an environment, who can connect to any of its enabled VPNs",
+	}
+
+	cmd.AddCommand(add.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(remove.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vpn implements the `vpn` command
+package vpn
+
+import (
+	"github.com/cloud-ca/cca/cmd/cca/vpn/disable"
+	"github.com/cloud-ca/cca/cmd/cca/vpn/enable"
+	"github.com/cloud-ca/cca/cmd/cca/vpn/get"
+	"github.com/cloud-ca/cca/cmd/cca/vpn/list"
+	"github.com/cloud-ca/cca/cmd/cca/vpn/user"
+	"github.com/cloud-ca/cca/pkg/cli"
+	"github.com/cloud-ca/cca/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand returns a new cobra.Command for vpn
+func NewCommand(cli *cli.Wrapper) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "vpn",
+		Short: "Manage remote access VPNs and VPN users of an environment",
+		Long: util.LongDescription(`
+            Remote access VPNs are L2TP/IPsec VPNs on the source NAT public IP of a VPC, giving VPN
+            users access to its networks. VPN users are shared by all the VPNs of an environment.
+            VPNs can be referred to by either their public IP address or id.
+        `),
+	}
+
+	cmd.AddCommand(disable.NewCommand(cli))
+	cmd.AddCommand(enable.NewCommand(cli))
+	cmd.AddCommand(get.NewCommand(cli))
+	cmd.AddCommand(list.NewCommand(cli))
+	cmd.AddCommand(user.NewCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/cloud-ca/cca/pkg/client"
+)
+
+// Exit codes of cca, distinguishing the outcomes of the tasks waited for
+const (
+	ExitSuccess     = 0
+	ExitError       = 1
+	ExitTaskFailed  = 2
+	ExitTaskTimeout = 3
+)
+
+// ExitCode returns the exit code of cca for the error returned by a command
+func ExitCode(err error) int {
+	switch err.(type) {
+	case nil:
+		return ExitSuccess
+	case *client.TaskFailedError:
+		return ExitTaskFailed
+	case *client.TaskTimeoutError, *client.TimeoutError:
+		return ExitTaskTimeout
+	default:
+		return ExitError
+	}
+}
@@ -16,9 +16,15 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/cloud-ca/cca/pkg/client"
 	"github.com/cloud-ca/cca/pkg/flags"
 	"github.com/cloud-ca/cca/pkg/output"
+	"github.com/cloud-ca/cca/pkg/resolve"
+	"github.com/cloud-ca/go-cloudca/configuration"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
 )
 
 // Wrapper of different parts of cca cli
@@ -26,4 +32,59 @@ type Wrapper struct {
 	GlobalFlags   *flags.GlobalFlags
 	OutputBuilder *output.Builder
 	CcaClient     *client.Client
+	WaitFlags     *flags.WaitFlags
+
+	environment *configuration.Environment
+}
+
+// Environment returns the environment selected with the global flag,
+// resolved by either its name or id. It's only looked up once.
+func (w *Wrapper) Environment() (*configuration.Environment, error) {
+	if w.environment != nil {
+		return w.environment, nil
+	}
+	if w.GlobalFlags.EnvironmentID == "" {
+		return nil, errors.New("environment must be provided with --environment flag, CCA_ENVIRONMENT environment variable or the profile")
+	}
+	environment, err := resolve.Environment(w.CcaClient.Environments, w.GlobalFlags.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+	w.environment = environment
+	return w.environment, nil
+}
+
+// Resources returns the services to manage the resources of the selected environment
+func (w *Wrapper) Resources() (cloudca.Resources, error) {
+	environment, err := w.Environment()
+	if err != nil {
+		return cloudca.Resources{}, err
+	}
+	resources, err := w.CcaClient.GetResources(environment.ServiceConnection.ServiceCode, environment.Name)
+	if err != nil {
+		return cloudca.Resources{}, err
+	}
+	ccaResources, ok := resources.(cloudca.Resources)
+	if !ok {
+		return cloudca.Resources{}, fmt.Errorf("service '%s' of environment '%s' is not supported", environment.ServiceConnection.ServiceCode, environment.Name)
+	}
+	return ccaResources, nil
+}
+
+// HasPendingTasks returns true if the command has not waited for
+// the completion of its operations (i.e. with --no-wait flag)
+func (w *Wrapper) HasPendingTasks() bool {
+	return len(w.CcaClient.PendingTasks()) > 0
+}
+
+// FormatPendingTasks prints the tasks which have not been waited for,
+// in place of the result of the operations
+func (w *Wrapper) FormatPendingTasks() error {
+	tasks := w.CcaClient.PendingTasks()
+	return w.OutputBuilder.Build(func(formatter *output.Formatter) error {
+		if len(tasks) == 1 {
+			return formatter.Format(tasks[0])
+		}
+		return formatter.Format(tasks)
+	})
 }
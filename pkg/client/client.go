@@ -16,18 +16,87 @@
 package client
 
 import (
+	"github.com/cloud-ca/cca/pkg/credentials"
 	gocca "github.com/cloud-ca/go-cloudca"
+	"github.com/cloud-ca/go-cloudca/api"
+	"github.com/cloud-ca/go-cloudca/services"
+	"github.com/sirupsen/logrus"
 )
 
 // Client to interact with cloud.ca infrastructure
 type Client struct {
 	*gocca.CcaClient
+
+	tasks *taskClient
 }
 
 // NewClient returns a new client to interact with cloud.ca
-// infrastructure with provided API URL and Key
-func NewClient(url string, key string) *Client {
+// infrastructure with provided API URL and Key. If the Key is
+// empty, the one stored by `cca login` for the profile is used.
+// The tasks of mutating operations are handled based on options.
+func NewClient(url string, key string, profile string, options TaskOptions) *Client {
+	if key == "" {
+		key = storedKey(profile)
+	}
+	tasks := newTaskClient(api.NewApiClient(url, key), options)
 	return &Client{
-		CcaClient: gocca.NewCcaClientWithURL(url, key),
+		CcaClient: gocca.NewCcaClientWithApiClient(tasks),
+		tasks:     tasks,
+	}
+}
+
+// PendingTasks returns the tasks which have not been waited for
+func (c *Client) PendingTasks() []Task {
+	return c.tasks.pending
+}
+
+// GetTask returns the task with the given id
+func (c *Client) GetTask(id string) (*Task, error) {
+	t, err := c.tasks.tasks.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return newTask(t), nil
+}
+
+// WaitTask waits for the task with the given id to complete, with the
+// timeout and poll interval of the options the client was created with
+func (c *Client) WaitTask(id string) (*Task, error) {
+	t, err := c.tasks.wait(id)
+	if err != nil {
+		return nil, err
+	}
+	return newTask(t), nil
+}
+
+// WatchTask polls the task with the given id until it is completed, like
+// WaitTask, calling fn every time the status of the task changes
+func (c *Client) WatchTask(id string, fn func(t *Task) error) (*Task, error) {
+	status := ""
+	t, err := c.tasks.poll(id, func(t *services.Task) error {
+		if t.Status == status {
+			return nil
+		}
+		status = t.Status
+		return fn(newTask(t))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newTask(t), nil
+}
+
+// storedKey returns the API key of the profile from the credentials store
+func storedKey(profile string) string {
+	store, err := credentials.NewStore()
+	if err != nil {
+		logrus.Warnf("Cannot open credentials store: %s", err)
+		return ""
+	}
+	key, err := store.Get(profile)
+	if err != nil {
+		logrus.Warnf("Cannot read credentials of profile '%s': %s", profile, err)
+		return ""
 	}
+	return key
 }
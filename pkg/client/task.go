@@ -0,0 +1,174 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cloud-ca/go-cloudca/api"
+	"github.com/cloud-ca/go-cloudca/services"
+	logutil "sigs.k8s.io/kind/pkg/log"
+)
+
+// TaskOptions controls how the asynchronous tasks created by
+// the mutating operations are waited for
+type TaskOptions struct {
+	Wait         bool
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// Task is an asynchronous operation of cloud.ca
+type Task struct {
+	ID      string      `json:"id" yaml:"id"`
+	Status  string      `json:"status" yaml:"status"`
+	Created string      `json:"created,omitempty" yaml:"created,omitempty"`
+	Result  interface{} `json:"result,omitempty" yaml:"result,omitempty"`
+}
+
+// newTask converts the task of go-cloudca, whose result is raw JSON
+func newTask(t *services.Task) *Task {
+	task := &Task{
+		ID:      t.Id,
+		Status:  t.Status,
+		Created: t.Created,
+	}
+	if len(t.Result) > 0 {
+		var result interface{}
+		if err := json.Unmarshal(t.Result, &result); err == nil {
+			task.Result = result
+		} else {
+			task.Result = string(t.Result)
+		}
+	}
+	return task
+}
+
+// TaskFailedError is returned when a task completes with failure
+type TaskFailedError struct {
+	Task *Task
+}
+
+func (e *TaskFailedError) Error() string {
+	if e.Task.Result == nil {
+		return fmt.Sprintf("task %s failed", e.Task.ID)
+	}
+	result, err := json.Marshal(e.Task.Result)
+	if err != nil {
+		return fmt.Sprintf("task %s failed", e.Task.ID)
+	}
+	return fmt.Sprintf("task %s failed: %s", e.Task.ID, result)
+}
+
+// TaskTimeoutError is returned when a task is not completed in time
+type TaskTimeoutError struct {
+	Task    *Task
+	Timeout time.Duration
+}
+
+func (e *TaskTimeoutError) Error() string {
+	return fmt.Sprintf("task %s is still %s after %s", e.Task.ID, e.Task.Status, e.Timeout)
+}
+
+// taskClient is an api.ApiClient which takes over the polling of the tasks
+// from go-cloudca, in order to either wait for them with timeout and progress
+// status, or not wait at all and keep track of them as pending
+type taskClient struct {
+	api.ApiClient
+	options TaskOptions
+	tasks   services.TaskService
+	pending []Task
+}
+
+func newTaskClient(apiClient api.ApiClient, options TaskOptions) *taskClient {
+	return &taskClient{
+		ApiClient: apiClient,
+		options:   options,
+		tasks:     services.NewTaskService(apiClient),
+	}
+}
+
+// Do sends the request and, if it resulted in a pending task, returns the
+// response of the completed task or a fake successful one if not waiting
+func (c *taskClient) Do(request api.CcaRequest) (*api.CcaResponse, error) {
+	response, err := c.ApiClient.Do(request)
+	if err != nil || response.IsError() || response.TaskId == "" {
+		return response, err
+	}
+	if strings.EqualFold(response.TaskStatus, services.SUCCESS) || strings.EqualFold(response.TaskStatus, services.FAILED) {
+		return response, nil
+	}
+	if !c.options.Wait {
+		c.pending = append(c.pending, Task{ID: response.TaskId, Status: services.PENDING})
+		completed := *response
+		completed.TaskStatus = services.SUCCESS
+		completed.Data = []byte("{}")
+		return &completed, nil
+	}
+	task, err := c.wait(response.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	completed := *response
+	completed.TaskStatus = task.Status
+	completed.Data = task.Result
+	return &completed, nil
+}
+
+// wait polls the task until it is completed, or the timeout is reached,
+// showing a spinner on terminals and plain progress lines otherwise
+func (c *taskClient) wait(id string) (*services.Task, error) {
+	status := logutil.NewStatus(os.Stderr)
+	status.Start(fmt.Sprintf("Waiting for task %s", id))
+
+	t, err := c.poll(id, nil)
+	if err != nil {
+		status.End(false)
+		return nil, err
+	}
+	status.End(true)
+	return t, nil
+}
+
+// poll gets the task every poll interval until it is completed, or the
+// timeout is reached, calling fn, if any, with every status of the task
+func (c *taskClient) poll(id string, fn func(t *services.Task) error) (*services.Task, error) {
+	start := time.Now()
+	for {
+		t, err := c.tasks.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if fn != nil {
+			if err := fn(t); err != nil {
+				return nil, err
+			}
+		}
+		if t.Completed() {
+			if t.Failed() {
+				return nil, &TaskFailedError{Task: newTask(t)}
+			}
+			return t, nil
+		}
+		if c.options.Timeout > 0 && time.Since(start) >= c.options.Timeout {
+			return nil, &TaskTimeoutError{Task: newTask(t), Timeout: c.options.Timeout}
+		}
+		time.Sleep(c.options.PollInterval)
+	}
+}
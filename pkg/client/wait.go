@@ -0,0 +1,60 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	logutil "sigs.k8s.io/kind/pkg/log"
+)
+
+// TimeoutError is returned when a condition waited for is not met in time
+type TimeoutError struct {
+	Description string
+	Timeout     time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s", e.Timeout, e.Description)
+}
+
+// WaitUntil calls fn every poll interval until it returns true, or the
+// timeout of the options the client was created with is reached, showing
+// the description with a spinner on terminals and plain lines otherwise
+func (c *Client) WaitUntil(description string, fn func() (bool, error)) error {
+	options := c.tasks.options
+	status := logutil.NewStatus(os.Stderr)
+	status.Start(fmt.Sprintf("Waiting for %s", description))
+
+	start := time.Now()
+	for {
+		done, err := fn()
+		if err != nil {
+			status.End(false)
+			return err
+		}
+		if done {
+			status.End(true)
+			return nil
+		}
+		if options.Timeout > 0 && time.Since(start) >= options.Timeout {
+			status.End(false)
+			return &TimeoutError{Description: description, Timeout: options.Timeout}
+		}
+		time.Sleep(options.PollInterval)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config contains the configuration file and named profiles of the cca cli
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultProfile is the name of the profile used when none is selected
+	DefaultProfile = "default"
+
+	// CurrentProfileKey is the key of the selected profile in the configuration file
+	CurrentProfileKey = "current-profile"
+
+	// ProfilesKey is the key of the named profiles in the configuration file
+	ProfilesKey = "profiles"
+)
+
+// Dir returns the directory holding cca files, i.e. ~/.cca
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cca"), nil
+}
+
+// Path returns the location of the configuration file, i.e. ~/.cca/config.yaml
+func Path() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.yaml"), nil
+}
+
+// ProfileKey returns the key of the setting 'name' of the 'profile' to be
+// looked up in viper (e.g. profiles.staging.api-key)
+func ProfileKey(profile string, name string) string {
+	return ProfilesKey + "." + profile + "." + name
+}
+
+// ValidateProfileName checks that a profile name can be used in the keys
+// looked up in viper, i.e. that it is not empty and has no dots
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("invalid profile name '%s', it cannot contain '.'", name)
+	}
+	return nil
+}
+
+// Load reads the configuration file into viper. It is not an error
+// if the file does not exist, in which case nothing is loaded.
+func Load() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	viper.SetConfigFile(path)
+	viper.SetConfigType("yaml")
+	return viper.ReadInConfig()
+}
@@ -0,0 +1,43 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "testing"
+
+func TestValidateProfileName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"default", true},
+		{"prod-eu", true},
+		{"prod_eu", true},
+		{"Staging2", true},
+		{"", false},
+		{"prod.eu", false},
+		{".", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateProfileName(test.name)
+			if test.valid && err != nil {
+				t.Errorf("expected %q to be valid, got %s", test.name, err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected %q to be invalid", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cloud-ca/cca/pkg/util"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Keys are the settings which can be stored in a profile
+var Keys = []string{"api-url", "api-key", "environment", "output", "loglevel"}
+
+// Config represents the content of the configuration file
+type Config struct {
+	CurrentProfile string              `json:"current-profile,omitempty" yaml:"current-profile,omitempty"`
+	Profiles       map[string]*Profile `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+}
+
+// Profile is a named set of values of the global flags
+type Profile struct {
+	APIURL       string `json:"api-url,omitempty" yaml:"api-url,omitempty"`
+	APIKey       string `json:"api-key,omitempty" yaml:"api-key,omitempty"`
+	Environment  string `json:"environment,omitempty" yaml:"environment,omitempty"`
+	OutputFormat string `json:"output,omitempty" yaml:"output,omitempty"`
+	LogLevel     string `json:"loglevel,omitempty" yaml:"loglevel,omitempty"`
+}
+
+// Read reads and parses the configuration file. An empty
+// configuration is returned if the file does not exist.
+func Read() (*Config, error) {
+	cfg := &Config{
+		Profiles: map[string]*Profile{},
+	}
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %s", path, err)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]*Profile{}
+	}
+	return cfg, nil
+}
+
+// Write atomically writes the configuration file, only readable
+// and writable by the current user as it contains API keys
+func (c *Config) Write() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return util.WriteFileAtomic(path, content, 0600)
+}
+
+// Profile returns the profile with provided name, and creates it if needed
+func (c *Config) Profile(name string) *Profile {
+	profile, ok := c.Profiles[name]
+	if !ok || profile == nil {
+		profile = &Profile{}
+		c.Profiles[name] = profile
+	}
+	return profile
+}
+
+// ProfileNames returns the sorted names of all the profiles
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Masked returns a copy of the configuration with API keys masked
+func (c *Config) Masked() *Config {
+	masked := &Config{
+		CurrentProfile: c.CurrentProfile,
+		Profiles:       map[string]*Profile{},
+	}
+	for name, profile := range c.Profiles {
+		if profile == nil {
+			continue
+		}
+		p := *profile
+		p.APIKey = MaskAPIKey(p.APIKey)
+		masked.Profiles[name] = &p
+	}
+	return masked
+}
+
+// Get returns the value of setting 'key'
+func (p *Profile) Get(key string) (string, error) {
+	field, err := p.field(key)
+	if err != nil {
+		return "", err
+	}
+	return *field, nil
+}
+
+// Set sets the value of setting 'key'
+func (p *Profile) Set(key string, value string) error {
+	field, err := p.field(key)
+	if err != nil {
+		return err
+	}
+	*field = value
+	return nil
+}
+
+// Unset removes the value of setting 'key'
+func (p *Profile) Unset(key string) error {
+	return p.Set(key, "")
+}
+
+func (p *Profile) field(key string) (*string, error) {
+	switch key {
+	case "api-url":
+		return &p.APIURL, nil
+	case "api-key":
+		return &p.APIKey, nil
+	case "environment":
+		return &p.Environment, nil
+	case "output":
+		return &p.OutputFormat, nil
+	case "loglevel":
+		return &p.LogLevel, nil
+	}
+	return nil, fmt.Errorf("unknown setting '%s', must be one of [%s]", key, strings.Join(Keys, ", "))
+}
+
+// MaskAPIKey hides all but the last four characters of the API key
+func MaskAPIKey(key string) string {
+	if key == "" {
+		return ""
+	}
+	if len(key) <= 8 {
+		return strings.Repeat("*", len(key))
+	}
+	return strings.Repeat("*", len(key)-4) + key[len(key)-4:]
+}
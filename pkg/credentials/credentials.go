@@ -0,0 +1,161 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package credentials stores the API keys of the profiles in an encrypted
+// file, separately from the configuration file.
+//
+// The credentials are encrypted with AES-256-GCM using a randomly generated
+// key stored in its own file. Both files are only readable by the current
+// user. This keeps API keys out of plain text dotfiles (e.g. when they are
+// shared or synced), but does not protect them from someone who has access
+// to the whole ~/.cca directory.
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/cloud-ca/cca/pkg/util"
+)
+
+const keySize = 32
+
+// Store of the API keys, by profile name
+type Store struct {
+	path    string
+	keyPath string
+}
+
+// NewStore returns the credentials store located in ~/.cca
+func NewStore() (*Store, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		path:    filepath.Join(dir, "credentials"),
+		keyPath: filepath.Join(dir, "credentials.key"),
+	}, nil
+}
+
+// Get returns the API key of the profile, or empty string if none is stored
+func (s *Store) Get(profile string) (string, error) {
+	keys, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	return keys[profile], nil
+}
+
+// Set stores the API key of the profile
+func (s *Store) Set(profile string, apiKey string) error {
+	keys, err := s.read()
+	if err != nil {
+		return err
+	}
+	keys[profile] = apiKey
+	return s.write(keys)
+}
+
+// Delete removes the API key of the profile, and returns false if there was none
+func (s *Store) Delete(profile string) (bool, error) {
+	keys, err := s.read()
+	if err != nil {
+		return false, err
+	}
+	if _, ok := keys[profile]; !ok {
+		return false, nil
+	}
+	delete(keys, profile)
+	return true, s.write(keys)
+}
+
+func (s *Store) read() (map[string]string, error) {
+	keys := map[string]string{}
+	content, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return keys, nil
+	} else if err != nil {
+		return nil, err
+	}
+	gcm, err := s.cipher(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) < gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid credentials file %s", s.path)
+	}
+	nonce, sealed := content[:gcm.NonceSize()], content[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt credentials file %s: %s", s.path, err)
+	}
+	if err := json.Unmarshal(plain, &keys); err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %s", s.path, err)
+	}
+	return keys, nil
+}
+
+func (s *Store) write(keys map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	gcm, err := s.cipher(true)
+	if err != nil {
+		return err
+	}
+	plain, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	return util.WriteFileAtomic(s.path, gcm.Seal(nonce, nonce, plain, nil), 0600)
+}
+
+// cipher returns the AES-GCM cipher from the key file, which
+// is generated if it does not exist and 'create' is true
+func (s *Store) cipher(create bool) (cipher.AEAD, error) {
+	key, err := ioutil.ReadFile(s.keyPath)
+	if os.IsNotExist(err) && create {
+		key = make([]byte, keySize)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+		if err := util.WriteFileAtomic(s.keyPath, key, 0600); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	if len(key) != keySize {
+		return nil, errors.New("invalid credentials key file " + s.keyPath)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
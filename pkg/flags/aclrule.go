@@ -0,0 +1,148 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+// ACLRuleSyntax describes the compact syntax of the network ACL rules
+const ACLRuleSyntax = "<allow|deny> <tcp|udp|icmp|all> <cidr> [port[-port]|type:code] [ingress|egress] [#number]"
+
+var (
+	aclActions = map[string]string{
+		"allow": "Allow",
+		"deny":  "Deny",
+	}
+	aclProtocols = map[string]string{
+		"tcp":  "TCP",
+		"udp":  "UDP",
+		"icmp": "ICMP",
+		"all":  "All",
+	}
+	aclTrafficTypes = map[string]string{
+		"ingress": "Ingress",
+		"egress":  "Egress",
+	}
+)
+
+// ParseACLRule parses a network ACL rule in the compact syntax, e.g.
+// 'allow tcp 0.0.0.0/0 443 ingress #100'. The traffic type is ingress
+// if omitted, and the rule number is left to the API to assign.
+func ParseACLRule(value string) (*cloudca.NetworkAclRule, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid ACL rule '%s', %s, must be in the form of '%s'", value, reason, ACLRuleSyntax)
+	}
+	fields := strings.Fields(strings.ToLower(value))
+	if len(fields) < 3 {
+		return nil, invalid("missing action, protocol or cidr")
+	}
+	rule := &cloudca.NetworkAclRule{
+		TrafficType: aclTrafficTypes["ingress"],
+	}
+	var ok bool
+	if rule.Action, ok = aclActions[fields[0]]; !ok {
+		return nil, invalid(fmt.Sprintf("unknown action '%s'", fields[0]))
+	}
+	if rule.Protocol, ok = aclProtocols[fields[1]]; !ok {
+		return nil, invalid(fmt.Sprintf("unknown protocol '%s'", fields[1]))
+	}
+	if _, _, err := net.ParseCIDR(fields[2]); err != nil {
+		return nil, invalid(fmt.Sprintf("invalid cidr '%s'", fields[2]))
+	}
+	rule.Cidr = fields[2]
+	var hasNumber, hasTrafficType, hasPort bool
+	for _, field := range fields[3:] {
+		if strings.HasPrefix(field, "#") {
+			if hasNumber {
+				return nil, invalid(fmt.Sprintf("duplicate rule number '%s'", field))
+			}
+			if _, err := strconv.Atoi(field[1:]); err != nil {
+				return nil, invalid(fmt.Sprintf("invalid rule number '%s'", field))
+			}
+			rule.RuleNumber = field[1:]
+			hasNumber = true
+			continue
+		}
+		if trafficType, ok := aclTrafficTypes[field]; ok {
+			if hasTrafficType {
+				return nil, invalid(fmt.Sprintf("duplicate traffic type '%s'", field))
+			}
+			rule.TrafficType = trafficType
+			hasTrafficType = true
+			continue
+		}
+		if hasPort {
+			return nil, invalid(fmt.Sprintf("unexpected '%s', only one port range or ICMP type and code is allowed", field))
+		}
+		var err error
+		switch fields[1] {
+		case "tcp", "udp":
+			rule.StartPort, rule.EndPort, err = parsePortRange(field)
+		case "icmp":
+			rule.IcmpType, rule.IcmpCode, err = parseIcmp(field)
+		default:
+			err = fmt.Errorf("unexpected '%s', protocol %s has no ports", field, fields[1])
+		}
+		if err != nil {
+			return nil, invalid(err.Error())
+		}
+		hasPort = true
+	}
+	if (fields[1] == "tcp" || fields[1] == "udp") && rule.StartPort == "" {
+		return nil, invalid(fmt.Sprintf("missing port of protocol %s", fields[1]))
+	}
+	return rule, nil
+}
+
+// parsePortRange parses either a single port or a range of ports, whose
+// start cannot be greater than its end
+func parsePortRange(value string) (string, string, error) {
+	parts := strings.SplitN(value, "-", 2)
+	ports := make([]int, len(parts))
+	for i, part := range parts {
+		port, err := strconv.Atoi(part)
+		if err != nil || port < 1 || port > 65535 {
+			return "", "", fmt.Errorf("invalid port '%s'", part)
+		}
+		ports[i] = port
+	}
+	if len(parts) == 1 {
+		return parts[0], parts[0], nil
+	}
+	if ports[0] > ports[1] {
+		return "", "", fmt.Errorf("invalid port range '%s', its start is greater than its end", value)
+	}
+	return parts[0], parts[1], nil
+}
+
+// parseIcmp parses the ICMP type and code, -1 meaning any
+func parseIcmp(value string) (string, string, error) {
+	parts := strings.SplitN(value, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid ICMP type and code '%s'", value)
+	}
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return "", "", fmt.Errorf("invalid ICMP type and code '%s'", value)
+		}
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,110 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+func TestParseACLRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected cloudca.NetworkAclRule
+	}{
+		{
+			"tcp port",
+			"allow tcp 0.0.0.0/0 443",
+			cloudca.NetworkAclRule{Action: "Allow", Protocol: "TCP", Cidr: "0.0.0.0/0", StartPort: "443", EndPort: "443", TrafficType: "Ingress"},
+		},
+		{
+			"udp port range",
+			"deny udp 10.0.0.0/8 1000-2000 egress",
+			cloudca.NetworkAclRule{Action: "Deny", Protocol: "UDP", Cidr: "10.0.0.0/8", StartPort: "1000", EndPort: "2000", TrafficType: "Egress"},
+		},
+		{
+			"single port range",
+			"allow tcp 0.0.0.0/0 80-80",
+			cloudca.NetworkAclRule{Action: "Allow", Protocol: "TCP", Cidr: "0.0.0.0/0", StartPort: "80", EndPort: "80", TrafficType: "Ingress"},
+		},
+		{
+			"any order and case",
+			"ALLOW TCP 0.0.0.0/0 #100 Egress 22",
+			cloudca.NetworkAclRule{Action: "Allow", Protocol: "TCP", Cidr: "0.0.0.0/0", StartPort: "22", EndPort: "22", TrafficType: "Egress", RuleNumber: "100"},
+		},
+		{
+			"icmp",
+			"allow icmp 192.168.0.0/16 8:-1 #5",
+			cloudca.NetworkAclRule{Action: "Allow", Protocol: "ICMP", Cidr: "192.168.0.0/16", IcmpType: "8", IcmpCode: "-1", TrafficType: "Ingress", RuleNumber: "5"},
+		},
+		{
+			"all",
+			"deny all 0.0.0.0/0 egress",
+			cloudca.NetworkAclRule{Action: "Deny", Protocol: "All", Cidr: "0.0.0.0/0", TrafficType: "Egress"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := ParseACLRule(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*rule, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, *rule)
+			}
+		})
+	}
+}
+
+func TestParseACLRuleErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		err   string
+	}{
+		{"empty", "", "missing action, protocol or cidr"},
+		{"missing cidr", "allow tcp", "missing action, protocol or cidr"},
+		{"unknown action", "permit tcp 0.0.0.0/0 22", "unknown action 'permit'"},
+		{"unknown protocol", "allow sctp 0.0.0.0/0 22", "unknown protocol 'sctp'"},
+		{"invalid cidr", "allow tcp 0.0.0.0 22", "invalid cidr '0.0.0.0'"},
+		{"missing port", "allow tcp 0.0.0.0/0 ingress", "missing port of protocol tcp"},
+		{"invalid port", "allow tcp 0.0.0.0/0 http", "invalid port 'http'"},
+		{"port out of range", "allow tcp 0.0.0.0/0 65536", "invalid port '65536'"},
+		{"port zero", "allow udp 0.0.0.0/0 0-10", "invalid port '0'"},
+		{"reversed port range", "allow tcp 0.0.0.0/0 8080-80", "invalid port range '8080-80'"},
+		{"duplicate port", "allow tcp 0.0.0.0/0 22 443", "unexpected '443'"},
+		{"duplicate traffic type", "allow tcp 0.0.0.0/0 22 ingress egress", "duplicate traffic type 'egress'"},
+		{"duplicate rule number", "allow tcp 0.0.0.0/0 22 #1 #2", "duplicate rule number '#2'"},
+		{"invalid rule number", "allow tcp 0.0.0.0/0 22 #first", "invalid rule number '#first'"},
+		{"invalid icmp", "allow icmp 0.0.0.0/0 8", "invalid ICMP type and code '8'"},
+		{"duplicate icmp", "allow icmp 0.0.0.0/0 8:0 0:0", "unexpected '0:0'"},
+		{"ports of all", "allow all 0.0.0.0/0 22", "protocol all has no ports"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseACLRule(test.value)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected error containing %q, got %q", test.err, err)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -26,5 +28,41 @@ const (
 	DefaultLogLevel = logrus.WarnLevel
 
 	// DefaultOutputFormat is the default value if not provided with corresponding flag
+	// and STDOUT is not a terminal
 	DefaultOutputFormat = "json"
+
+	// DefaultTerminalOutputFormat is the default value if not provided with corresponding
+	// flag and STDOUT is a terminal
+	DefaultTerminalOutputFormat = "table"
+
+	// DefaultColor is the default value if not provided with corresponding flag
+	DefaultColor = "auto"
+
+	// DefaultTimeout is the default value if not provided with corresponding flag
+	DefaultTimeout = 30 * time.Minute
+
+	// DefaultPollInterval is the default value if not provided with corresponding flag
+	DefaultPollInterval = 1 * time.Second
+
+	// APIURLEnv is the environment variable used if not provided with corresponding flag
+	APIURLEnv = "CCA_API_URL"
+
+	// APIKeyEnv is the environment variable used if not provided with corresponding flag
+	APIKeyEnv = "CCA_API_KEY"
+
+	// EnvironmentEnv is the environment variable used if not provided with corresponding flag
+	EnvironmentEnv = "CCA_ENVIRONMENT"
+
+	// LogLevelEnv is the environment variable used if not provided with corresponding flag
+	LogLevelEnv = "CCA_LOGLEVEL"
+
+	// OutputFormatEnv is the environment variable used if not provided with corresponding flag
+	OutputFormatEnv = "CCA_OUTPUT"
+
+	// ProfileEnv is the environment variable used if not provided with corresponding flag
+	ProfileEnv = "CCA_PROFILE"
+
+	// NoColorEnv is the environment variable disabling colors when set, unless
+	// --color=always is provided (see https://no-color.org)
+	NoColorEnv = "NO_COLOR"
 )
@@ -15,9 +15,13 @@
 package flags
 
 import (
+	"os"
+
+	"github.com/cloud-ca/cca/pkg/config"
 	"github.com/cloud-ca/cca/pkg/output"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 // GlobalFlags for the cca command
@@ -27,19 +31,107 @@ type GlobalFlags struct {
 	EnvironmentID string
 	LogLevel      string
 	OutputFormat  string
+	Profile       string
+	Columns       []string
+	NoHeaders     bool
+	TemplateFile  string
+	Color         string
+	OutputFile    string
+
+	// names of the flags set from environment variables
+	fromEnv map[string]bool
+}
+
+// envBinding binds a global flag to its environment variable
+type envBinding struct {
+	flag  string
+	env   string
+	value *string
+}
+
+// ApplyEnv sets the global flags which have not been provided on the
+// command line from their corresponding environment variables
+func (gf *GlobalFlags) ApplyEnv(cmd *cobra.Command) {
+	bindings := []envBinding{
+		{flag: "api-url", env: APIURLEnv, value: &gf.APIURL},
+		{flag: "api-key", env: APIKeyEnv, value: &gf.APIKey},
+		{flag: "environment", env: EnvironmentEnv, value: &gf.EnvironmentID},
+		{flag: "output", env: OutputFormatEnv, value: &gf.OutputFormat},
+		{flag: "loglevel", env: LogLevelEnv, value: &gf.LogLevel},
+		{flag: "profile", env: ProfileEnv, value: &gf.Profile},
+	}
+	gf.fromEnv = map[string]bool{}
+	for _, b := range bindings {
+		if changed(cmd, b.flag) {
+			continue
+		}
+		if value := os.Getenv(b.env); value != "" {
+			*b.value = value
+			gf.fromEnv[b.flag] = true
+		}
+	}
 }
 
 // Normalize checks and normalizes input flags and falls back to default values when needed
 func (gf *GlobalFlags) Normalize(cmd *cobra.Command, fn func(key string) interface{}, args []string) error {
+	if err := gf.parseProfile(cmd, fn); err != nil {
+		return err
+	}
+	gf.fromProfile(cmd, fn, "api-url", &gf.APIURL)
+	gf.fromProfile(cmd, fn, "api-key", &gf.APIKey)
+	gf.fromProfile(cmd, fn, "environment", &gf.EnvironmentID)
+	gf.fromProfile(cmd, fn, "output", &gf.OutputFormat)
+	gf.fromProfile(cmd, fn, "loglevel", &gf.LogLevel)
+
 	if err := gf.parseLogLevel(cmd, args); err != nil {
 		return err
 	}
 	if err := gf.parseOutputFormat(cmd, args); err != nil {
 		return err
 	}
+	if err := gf.parseColor(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseProfile selects the profile from the command line, the CCA_PROFILE
+// environment variable or the current profile of the configuration file
+func (gf *GlobalFlags) parseProfile(cmd *cobra.Command, fn func(key string) interface{}) error {
+	if gf.isSet(cmd, "profile") {
+		return config.ValidateProfileName(gf.Profile)
+	}
+	if profile, ok := fn(config.CurrentProfileKey).(string); ok && profile != "" {
+		gf.Profile = profile
+	} else {
+		gf.Profile = config.DefaultProfile
+	}
 	return nil
 }
 
+// fromProfile sets the value of flag 'name' from the selected profile
+// when it has not been provided on the command line or environment variable
+func (gf *GlobalFlags) fromProfile(cmd *cobra.Command, fn func(key string) interface{}, name string, value *string) {
+	if gf.isSet(cmd, name) {
+		return
+	}
+	if v, ok := fn(config.ProfileKey(gf.Profile, name)).(string); ok && v != "" {
+		*value = v
+	}
+}
+
+// isSet returns true if flag 'name' has been provided on the command
+// line or with its corresponding environment variable
+func (gf *GlobalFlags) isSet(cmd *cobra.Command, name string) bool {
+	return changed(cmd, name) || gf.fromEnv[name]
+}
+
+// changed returns true if flag 'name' has been provided on the command line
+func changed(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flags().Lookup(name)
+	return flag != nil && flag.Changed
+}
+
 func (gf *GlobalFlags) parseLogLevel(cmd *cobra.Command, args []string) error {
 	level := DefaultLogLevel
 	parsed, err := logrus.ParseLevel(gf.LogLevel)
@@ -53,9 +145,51 @@ func (gf *GlobalFlags) parseLogLevel(cmd *cobra.Command, args []string) error {
 }
 
 func (gf *GlobalFlags) parseOutputFormat(cmd *cobra.Command, args []string) error {
-	if !output.Has(gf.OutputFormat) {
-		logrus.Warnf("Invalid output format '%s', defaulting to '%s'", gf.OutputFormat, DefaultOutputFormat)
-		gf.OutputFormat = DefaultOutputFormat
+	if gf.OutputFormat == "" {
+		gf.OutputFormat = gf.defaultOutputFormat()
+	} else if !output.Has(gf.OutputFormat) {
+		format := gf.defaultOutputFormat()
+		logrus.Warnf("Invalid output format '%s', defaulting to '%s'", gf.OutputFormat, format)
+		gf.OutputFormat = format
 	}
 	return nil
 }
+
+// defaultOutputFormat returns the table format when the output is printed to
+// a terminal, and JSON when it is written to a file or piped to another command
+func (gf *GlobalFlags) defaultOutputFormat() string {
+	if gf.OutputFile == "" && terminal.IsTerminal(int(os.Stdout.Fd())) {
+		return DefaultTerminalOutputFormat
+	}
+	return DefaultOutputFormat
+}
+
+// ColorModes are the accepted values of the --color flag
+var ColorModes = []string{"auto", "always", "never"}
+
+func (gf *GlobalFlags) parseColor(cmd *cobra.Command, args []string) error {
+	for _, mode := range ColorModes {
+		if gf.Color == mode {
+			return nil
+		}
+	}
+	logrus.Warnf("Invalid color mode '%s', defaulting to '%s'", gf.Color, DefaultColor)
+	gf.Color = DefaultColor
+	return nil
+}
+
+// Colorized returns true if the output is to be colorized, i.e. with
+// --color=always, or with --color=auto when the output is printed to a
+// terminal and the NO_COLOR environment variable is not set (or empty)
+func (gf *GlobalFlags) Colorized() bool {
+	switch gf.Color {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+	if gf.OutputFile != "" || os.Getenv(NoColorEnv) != "" {
+		return false
+	}
+	return terminal.IsTerminal(int(os.Stdout.Fd()))
+}
@@ -0,0 +1,324 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// newCommand returns a command with the global flags of cca bound to 'gf'
+func newCommand(gf *GlobalFlags) *cobra.Command {
+	cmd := &cobra.Command{Use: "cca"}
+	cmd.Flags().StringVar(&gf.APIURL, "api-url", DefaultAPIURL, "")
+	cmd.Flags().StringVar(&gf.APIKey, "api-key", "", "")
+	cmd.Flags().StringVarP(&gf.EnvironmentID, "environment", "e", "", "")
+	cmd.Flags().StringVarP(&gf.OutputFormat, "output", "o", "", "")
+	cmd.Flags().StringVar(&gf.Color, "color", DefaultColor, "")
+	cmd.Flags().StringVar(&gf.LogLevel, "loglevel", DefaultLogLevel.String(), "")
+	cmd.Flags().StringVar(&gf.Profile, "profile", "", "")
+	return cmd
+}
+
+// lookup returns a fake viper.Get reading the flattened 'settings'
+func lookup(settings map[string]string) func(key string) interface{} {
+	return func(key string) interface{} {
+		if value, ok := settings[key]; ok {
+			return value
+		}
+		return nil
+	}
+}
+
+// setenv sets the environment variable 'key', or unsets it if 'value' is
+// empty, and returns a function restoring its previous value
+func setenv(key string, value string) func() {
+	previous, ok := os.LookupEnv(key)
+	if value == "" {
+		os.Unsetenv(key)
+	} else {
+		os.Setenv(key, value)
+	}
+	return func() {
+		if ok {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+// withEnv sets the CCA_* environment variables to 'env', unsetting the
+// others, and returns a function restoring all of them
+func withEnv(env map[string]string) func() {
+	restores := []func(){}
+	for _, key := range []string{APIURLEnv, APIKeyEnv, EnvironmentEnv, OutputFormatEnv, LogLevelEnv, ProfileEnv} {
+		restores = append(restores, setenv(key, env[key]))
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+// normalize parses the command line 'args', applies the environment
+// variables and normalizes the global flags with the configuration file
+// 'settings'
+func normalize(t *testing.T, args []string, settings map[string]string) (*GlobalFlags, error) {
+	gf := &GlobalFlags{}
+	cmd := newCommand(gf)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	gf.ApplyEnv(cmd)
+	return gf, gf.Normalize(cmd, lookup(settings), nil)
+}
+
+var settings = map[string]string{
+	"current-profile":              "staging",
+	"profiles.staging.api-key":     "staging-key",
+	"profiles.staging.environment": "staging-env",
+	"profiles.staging.output":      "yaml",
+	"profiles.prod.api-url":        "https://prod.example.com/v1",
+	"profiles.prod.api-key":        "prod-key",
+	"profiles.default.api-key":     "default-key",
+}
+
+func TestNormalizeProfile(t *testing.T) {
+	defer withEnv(nil)()
+
+	withoutCurrent := map[string]string{}
+	for key, value := range settings {
+		if key != "current-profile" {
+			withoutCurrent[key] = value
+		}
+	}
+	tests := []struct {
+		name     string
+		args     []string
+		settings map[string]string
+		expected string
+	}{
+		{"flag", []string{"--profile", "prod"}, settings, "prod"},
+		{"current profile", nil, settings, "staging"},
+		{"default", nil, withoutCurrent, "default"},
+		{"flag of unknown profile", []string{"--profile", "other"}, settings, "other"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gf, err := normalize(t, test.args, test.settings)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gf.Profile != test.expected {
+				t.Errorf("expected profile %q, got %q", test.expected, gf.Profile)
+			}
+		})
+	}
+}
+
+func TestNormalizeProfileErrors(t *testing.T) {
+	defer withEnv(nil)()
+
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{"empty", []string{"--profile="}, "profile name cannot be empty"},
+		{"dotted", []string{"--profile", "prod.eu"}, "invalid profile name 'prod.eu'"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := normalize(t, test.args, settings)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected error containing %q, got %q", test.err, err)
+			}
+		})
+	}
+}
+
+func TestNormalizePrecedence(t *testing.T) {
+	defer withEnv(nil)()
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected GlobalFlags
+	}{
+		{
+			"current profile",
+			nil,
+			GlobalFlags{APIURL: DefaultAPIURL, APIKey: "staging-key", EnvironmentID: "staging-env", OutputFormat: "yaml"},
+		},
+		{
+			"selected profile",
+			[]string{"--profile", "prod", "-o", "json"},
+			GlobalFlags{APIURL: "https://prod.example.com/v1", APIKey: "prod-key", OutputFormat: "json"},
+		},
+		{
+			"flags over profile",
+			[]string{"--api-key", "flag-key", "-e", "flag-env", "-o", "ndjson", "--api-url", "https://flag.example.com/v1"},
+			GlobalFlags{APIURL: "https://flag.example.com/v1", APIKey: "flag-key", EnvironmentID: "flag-env", OutputFormat: "ndjson"},
+		},
+		{
+			"flag equal to the default value",
+			[]string{"--profile", "prod", "--api-url", DefaultAPIURL, "-o", "json"},
+			GlobalFlags{APIURL: DefaultAPIURL, APIKey: "prod-key", OutputFormat: "json"},
+		},
+		{
+			"invalid output format of flag",
+			[]string{"-o", "xml", "--api-key", "flag-key"},
+			GlobalFlags{APIURL: DefaultAPIURL, APIKey: "flag-key", EnvironmentID: "staging-env"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gf, err := normalize(t, test.args, settings)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gf.APIURL != test.expected.APIURL {
+				t.Errorf("expected api url %q, got %q", test.expected.APIURL, gf.APIURL)
+			}
+			if gf.APIKey != test.expected.APIKey {
+				t.Errorf("expected api key %q, got %q", test.expected.APIKey, gf.APIKey)
+			}
+			if gf.EnvironmentID != test.expected.EnvironmentID {
+				t.Errorf("expected environment %q, got %q", test.expected.EnvironmentID, gf.EnvironmentID)
+			}
+			// the default output format depends on stdout being a terminal
+			if test.expected.OutputFormat != "" && gf.OutputFormat != test.expected.OutputFormat {
+				t.Errorf("expected output format %q, got %q", test.expected.OutputFormat, gf.OutputFormat)
+			}
+		})
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		env      map[string]string
+		expected GlobalFlags
+	}{
+		{
+			"environment variables",
+			nil,
+			map[string]string{
+				APIURLEnv:       "https://env.example.com/v1",
+				APIKeyEnv:       "env-key",
+				EnvironmentEnv:  "env-env",
+				OutputFormatEnv: "csv",
+				LogLevelEnv:     "debug",
+			},
+			GlobalFlags{APIURL: "https://env.example.com/v1", APIKey: "env-key", EnvironmentID: "env-env", OutputFormat: "csv", LogLevel: "debug"},
+		},
+		{
+			"flags over environment variables",
+			[]string{"--api-url", "https://flag.example.com/v1", "--api-key", "flag-key", "-e", "flag-env", "-o", "tsv", "--loglevel", "error"},
+			map[string]string{
+				APIURLEnv:       "https://env.example.com/v1",
+				APIKeyEnv:       "env-key",
+				EnvironmentEnv:  "env-env",
+				OutputFormatEnv: "csv",
+				LogLevelEnv:     "debug",
+			},
+			GlobalFlags{APIURL: "https://flag.example.com/v1", APIKey: "flag-key", EnvironmentID: "flag-env", OutputFormat: "tsv", LogLevel: "error"},
+		},
+		{
+			"flags equal to the default values",
+			[]string{"--api-url", DefaultAPIURL, "--loglevel", DefaultLogLevel.String()},
+			map[string]string{APIURLEnv: "https://env.example.com/v1", LogLevelEnv: "debug"},
+			GlobalFlags{APIURL: DefaultAPIURL, LogLevel: DefaultLogLevel.String()},
+		},
+		{
+			"empty environment variables",
+			nil,
+			map[string]string{},
+			GlobalFlags{APIURL: DefaultAPIURL, LogLevel: DefaultLogLevel.String()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer withEnv(test.env)()
+			gf := &GlobalFlags{}
+			cmd := newCommand(gf)
+			if err := cmd.ParseFlags(test.args); err != nil {
+				t.Fatal(err)
+			}
+			gf.ApplyEnv(cmd)
+			if gf.APIURL != test.expected.APIURL {
+				t.Errorf("expected api url %q, got %q", test.expected.APIURL, gf.APIURL)
+			}
+			if gf.APIKey != test.expected.APIKey {
+				t.Errorf("expected api key %q, got %q", test.expected.APIKey, gf.APIKey)
+			}
+			if gf.EnvironmentID != test.expected.EnvironmentID {
+				t.Errorf("expected environment %q, got %q", test.expected.EnvironmentID, gf.EnvironmentID)
+			}
+			if gf.OutputFormat != test.expected.OutputFormat {
+				t.Errorf("expected output format %q, got %q", test.expected.OutputFormat, gf.OutputFormat)
+			}
+			if gf.LogLevel != test.expected.LogLevel {
+				t.Errorf("expected log level %q, got %q", test.expected.LogLevel, gf.LogLevel)
+			}
+		})
+	}
+}
+
+func TestApplyEnvPrecedence(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		env             map[string]string
+		expectedProfile string
+		expectedAPIKey  string
+	}{
+		{"environment over profile", nil, map[string]string{APIKeyEnv: "env-key"}, "staging", "env-key"},
+		{"flag over environment", []string{"--api-key", "flag-key"}, map[string]string{APIKeyEnv: "env-key"}, "staging", "flag-key"},
+		{"profile environment variable", nil, map[string]string{ProfileEnv: "prod"}, "prod", "prod-key"},
+		{"profile flag over environment variable", []string{"--profile", "default"}, map[string]string{ProfileEnv: "prod"}, "default", "default-key"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer withEnv(test.env)()
+			gf, err := normalize(t, test.args, settings)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gf.Profile != test.expectedProfile {
+				t.Errorf("expected profile %q, got %q", test.expectedProfile, gf.Profile)
+			}
+			if gf.APIKey != test.expectedAPIKey {
+				t.Errorf("expected api key %q, got %q", test.expectedAPIKey, gf.APIKey)
+			}
+		})
+	}
+}
+
+func TestApplyEnvInvalidProfile(t *testing.T) {
+	defer withEnv(map[string]string{ProfileEnv: "prod.eu"})()
+	if _, err := normalize(t, nil, settings); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PortForwardSyntax describes the shorthand syntax of the port forwarding rules
+const PortForwardSyntax = "<public-ip>:<port[-port]>-><instance>:<port[-port]>[/tcp|udp]"
+
+// PortForward is a port forwarding rule parsed from the shorthand syntax,
+// with the public IP and instance as provided, either by name or id
+type PortForward struct {
+	PublicIP         string
+	PublicPortStart  string
+	PublicPortEnd    string
+	Instance         string
+	PrivatePortStart string
+	PrivatePortEnd   string
+	Protocol         string
+}
+
+// ParsePortForward parses a port forwarding rule in the shorthand syntax,
+// e.g. '203.0.113.4:2222->web-1:22/tcp'. The protocol is tcp if omitted.
+func ParsePortForward(value string) (*PortForward, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid port forwarding rule '%s', %s, must be in the form of '%s'", value, reason, PortForwardSyntax)
+	}
+	rule := &PortForward{Protocol: "TCP"}
+	spec := strings.TrimSpace(value)
+	if i := strings.LastIndex(spec, "/"); i >= 0 {
+		switch protocol := strings.ToLower(spec[i+1:]); protocol {
+		case "tcp", "udp":
+			rule.Protocol = strings.ToUpper(protocol)
+		default:
+			return nil, invalid(fmt.Sprintf("unknown protocol '%s'", protocol))
+		}
+		spec = spec[:i]
+	}
+	sides := strings.SplitN(spec, "->", 2)
+	if len(sides) != 2 {
+		return nil, invalid("missing '->'")
+	}
+	var err error
+	if rule.PublicIP, rule.PublicPortStart, rule.PublicPortEnd, err = parseEndpoint(sides[0]); err != nil {
+		return nil, invalid(err.Error())
+	}
+	if rule.Instance, rule.PrivatePortStart, rule.PrivatePortEnd, err = parseEndpoint(sides[1]); err != nil {
+		return nil, invalid(err.Error())
+	}
+	if rangeSize(rule.PublicPortStart, rule.PublicPortEnd) != rangeSize(rule.PrivatePortStart, rule.PrivatePortEnd) {
+		return nil, invalid("public and private port ranges must have the same size")
+	}
+	return rule, nil
+}
+
+// parseEndpoint parses the 'host:port[-port]' side of a port forwarding rule
+func parseEndpoint(value string) (string, string, string, error) {
+	i := strings.LastIndex(value, ":")
+	if i <= 0 {
+		return "", "", "", fmt.Errorf("missing port of '%s'", value)
+	}
+	start, end, err := parsePortRange(value[i+1:])
+	if err != nil {
+		return "", "", "", err
+	}
+	return value[:i], start, end, nil
+}
+
+// rangeSize returns the number of ports of a range validated by parsePortRange
+func rangeSize(start, end string) int {
+	s, _ := strconv.Atoi(start)
+	e, _ := strconv.Atoi(end)
+	return e - s + 1
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePortForward(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected PortForward
+	}{
+		{
+			"single port",
+			"203.0.113.4:2222->web-1:22",
+			PortForward{PublicIP: "203.0.113.4", PublicPortStart: "2222", PublicPortEnd: "2222", Instance: "web-1", PrivatePortStart: "22", PrivatePortEnd: "22", Protocol: "TCP"},
+		},
+		{
+			"port range with protocol",
+			" 203.0.113.4:8000-8010->web-1:9000-9010/UDP ",
+			PortForward{PublicIP: "203.0.113.4", PublicPortStart: "8000", PublicPortEnd: "8010", Instance: "web-1", PrivatePortStart: "9000", PrivatePortEnd: "9010", Protocol: "UDP"},
+		},
+		{
+			"single port range",
+			"203.0.113.4:80-80->web-1:8080",
+			PortForward{PublicIP: "203.0.113.4", PublicPortStart: "80", PublicPortEnd: "80", Instance: "web-1", PrivatePortStart: "8080", PrivatePortEnd: "8080", Protocol: "TCP"},
+		},
+		{
+			"instance id",
+			"ip-1:443->1e2d3c4b-0000-4000-8000-000000000001:8443/tcp",
+			PortForward{PublicIP: "ip-1", PublicPortStart: "443", PublicPortEnd: "443", Instance: "1e2d3c4b-0000-4000-8000-000000000001", PrivatePortStart: "8443", PrivatePortEnd: "8443", Protocol: "TCP"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := ParsePortForward(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*rule, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, *rule)
+			}
+		})
+	}
+}
+
+func TestParsePortForwardErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		err   string
+	}{
+		{"empty", "", "missing '->'"},
+		{"missing arrow", "203.0.113.4:80 web-1:80", "missing '->'"},
+		{"unknown protocol", "203.0.113.4:80->web-1:80/icmp", "unknown protocol 'icmp'"},
+		{"missing public port", "203.0.113.4->web-1:80", "missing port of '203.0.113.4'"},
+		{"missing public ip", ":80->web-1:80", "missing port of ':80'"},
+		{"missing private port", "203.0.113.4:80->web-1", "missing port of 'web-1'"},
+		{"invalid port", "203.0.113.4:http->web-1:80", "invalid port 'http'"},
+		{"port out of range", "203.0.113.4:80->web-1:70000", "invalid port '70000'"},
+		{"reversed public range", "203.0.113.4:90-80->web-1:80-90", "invalid port range '90-80'"},
+		{"reversed private range", "203.0.113.4:80-90->web-1:90-80", "invalid port range '90-80'"},
+		{"range to single port", "203.0.113.4:80-90->web-1:8080", "must have the same size"},
+		{"single port to range", "203.0.113.4:80->web-1:8080-8081", "must have the same size"},
+		{"ranges of different sizes", "203.0.113.4:80-90->web-1:8080-8089", "must have the same size"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParsePortForward(test.value)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected error containing %q, got %q", test.err, err)
+			}
+		})
+	}
+}
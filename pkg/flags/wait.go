@@ -0,0 +1,67 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"time"
+
+	"github.com/cloud-ca/cca/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+// WaitFlags for the commands of mutating operations, which create asynchronous tasks
+type WaitFlags struct {
+	Wait         bool
+	NoWait       bool
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// NewWaitFlags returns WaitFlags with default values, which
+// apply to the commands not registering the flags at all
+func NewWaitFlags() *WaitFlags {
+	return &WaitFlags{
+		Wait:         true,
+		Timeout:      DefaultTimeout,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// AddFlags registers the wait flags on the command
+func (wf *WaitFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&wf.Wait, "wait", true, "wait for the operation to complete")
+	cmd.Flags().BoolVar(&wf.NoWait, "no-wait", false, "do not wait for the operation to complete and print its task instead")
+	wf.AddTimeoutFlags(cmd)
+}
+
+// AddTimeoutFlags registers only the flags controlling how long and how
+// often to poll, for the commands which always wait
+func (wf *WaitFlags) AddTimeoutFlags(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&wf.Timeout, "timeout", DefaultTimeout, "maximum time to wait for the operation to complete, 0 to wait forever")
+	cmd.Flags().DurationVar(&wf.PollInterval, "poll-interval", DefaultPollInterval, "interval between checks of the operation status")
+}
+
+// TaskOptions returns the options of the client handling the tasks
+func (wf *WaitFlags) TaskOptions() client.TaskOptions {
+	interval := wf.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return client.TaskOptions{
+		Wait:         wf.Wait && !wf.NoWait,
+		Timeout:      wf.Timeout,
+		PollInterval: interval,
+	}
+}
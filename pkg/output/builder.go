@@ -14,25 +14,113 @@
 
 package output
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"text/template"
+
+	"github.com/cloud-ca/cca/pkg/util"
+)
+
 // Builder is used to prepare the output. It internally
 // create a Formatter and use it to print the 'object'
 // to different formats and colors (based on the flags)
 type Builder struct {
-	format string
+	out  io.Writer
+	opts Options
+
+	// format is the name of the output format, without its template
+	format     string
+	jsonPath   *jsonPath
+	goTemplate *template.Template
+}
+
+// Options of the output, provided with the global flags
+type Options struct {
+	// Format is one of the available output formats, followed
+	// by its template for the jsonpath and go-template formats
+	Format string
+
+	// Columns are the fields shown by the table, csv and tsv formats. The
+	// curated default columns of the printed type are used by the table
+	// format if empty, and all the flattened fields by the csv and tsv ones
+	Columns []string
+
+	// NoHeaders omits the header row of the table, csv and tsv formats
+	NoHeaders bool
+
+	// TemplateFile is the file containing the template of the
+	// jsonpath and go-template formats
+	TemplateFile string
+
+	// Color colorizes the JSON and YAML formats, and the state
+	// and status columns of the table format
+	Color bool
+
+	// OutputFile is the file the output is written to instead of the
+	// writer of the Builder. It is written atomically once the output
+	// is complete, so that it is left untouched if formatting fails.
+	OutputFile string
 }
 
-// NewBuilder returns a new output.Builder with desired format and colored output
-func NewBuilder(format string) *Builder {
-	return &Builder{
-		format: format,
+// NewBuilder returns a new output.Builder writing to 'out' with desired format and
+// colored output. The template of the jsonpath and go-template formats is parsed
+// beforehand so that an invalid one is reported before any operation is made.
+func NewBuilder(out io.Writer, opts Options) (*Builder, error) {
+	b := &Builder{
+		out:    out,
+		opts:   opts,
+		format: opts.Format,
+	}
+	name, text, hasText := splitFormat(opts.Format)
+	if !isTemplateFormat(name) {
+		if opts.TemplateFile != "" {
+			return nil, fmt.Errorf("--template-file is only supported by the %s output formats", strings.Join(templateFormats, " and "))
+		}
+		return b, nil
+	}
+	b.format = name
+	switch {
+	case hasText && opts.TemplateFile != "":
+		return nil, fmt.Errorf("the template of the %s output format is provided both inline and with --template-file", name)
+	case opts.TemplateFile != "":
+		content, err := ioutil.ReadFile(opts.TemplateFile)
+		if err != nil {
+			return nil, err
+		}
+		text = string(content)
+	case !hasText:
+		return nil, fmt.Errorf("the %s output format requires a template, e.g. --output %s=<template> or --template-file", name, name)
+	}
+
+	var err error
+	if name == "jsonpath" {
+		b.jsonPath, err = parseJSONPath(text)
+	} else {
+		b.goTemplate, err = template.New(name).Parse(text)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
 }
 
 // Build builds the callback function to be used directly in cobra.Command
 // in order not to pass around private structs from go-cloudca library
 func (b *Builder) Build(fn func(*Formatter) error) error {
-	formatter := &Formatter{
-		builder: b,
+	if b.opts.OutputFile == "" {
+		return fn(&Formatter{
+			builder: b,
+			out:     b.out,
+		})
+	}
+	var buf bytes.Buffer
+	if err := fn(&Formatter{builder: b, out: &buf}); err != nil {
+		return err
 	}
-	return fn(formatter)
+	// the output may contain secrets, e.g. passwords or pre-shared keys
+	return util.WriteFileAtomic(b.opts.OutputFile, buf.Bytes(), 0600)
 }
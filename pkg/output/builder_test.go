@@ -0,0 +1,134 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewBuilder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cca-output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	templateFile := filepath.Join(dir, "template")
+	if err := ioutil.WriteFile(templateFile, []byte("{{.ID}}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{"json", Options{Format: "json"}, false},
+		{"inline jsonpath", Options{Format: "jsonpath={.id}"}, false},
+		{"inline go-template", Options{Format: "go-template={{.ID}}"}, false},
+		{"template file", Options{Format: "go-template", TemplateFile: templateFile}, false},
+		{"inline template and template file", Options{Format: "go-template={{.ID}}", TemplateFile: templateFile}, true},
+		{"missing jsonpath template", Options{Format: "jsonpath"}, true},
+		{"missing go-template template", Options{Format: "go-template"}, true},
+		{"template file without template format", Options{Format: "json", TemplateFile: templateFile}, true},
+		{"missing template file", Options{Format: "jsonpath", TemplateFile: filepath.Join(dir, "missing")}, true},
+		{"invalid jsonpath template", Options{Format: "jsonpath={.id"}, true},
+		{"invalid go-template template", Options{Format: "go-template={{.ID"}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewBuilder(&bytes.Buffer{}, test.opts)
+			if test.wantErr && err == nil {
+				t.Errorf("NewBuilder(%+v) succeeded, expected an error", test.opts)
+			} else if !test.wantErr && err != nil {
+				t.Errorf("NewBuilder(%+v) failed: %s", test.opts, err)
+			}
+		})
+	}
+}
+
+func TestBuildOutputFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cca-output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	outputFile := filepath.Join(dir, "output.json")
+
+	var stdout bytes.Buffer
+	builder, err := NewBuilder(&stdout, Options{Format: "jsonpath={.id}", OutputFile: outputFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	build := func(id string, fail bool) error {
+		return builder.Build(func(formatter *Formatter) error {
+			if err := formatter.Format(map[string]string{"id": id}); err != nil {
+				return err
+			}
+			if fail {
+				return errors.New("formatting failed")
+			}
+			return nil
+		})
+	}
+
+	if err := build("i1", false); err != nil {
+		t.Fatal(err)
+	}
+	assertFile(t, outputFile, "i1")
+	if stdout.Len() != 0 {
+		t.Errorf("output written to the writer of the builder: %q", stdout.String())
+	}
+	info, err := os.Stat(outputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("output file permission is %o, expected 600", info.Mode().Perm())
+	}
+
+	if err := build("i2", true); err == nil {
+		t.Fatal("Build succeeded, expected an error")
+	}
+	assertFile(t, outputFile, "i1")
+
+	if err := build("i3", false); err != nil {
+		t.Fatal(err)
+	}
+	assertFile(t, outputFile, "i3")
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("temporary files left next to the output file: %d files found", len(files))
+	}
+}
+
+func assertFile(t *testing.T, path string, expected string) {
+	t.Helper()
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != expected {
+		t.Errorf("output file contains %q, expected %q", content, expected)
+	}
+}
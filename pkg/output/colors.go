@@ -0,0 +1,105 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ANSI escape sequences of the colors of the output
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+
+	// colorKey is the color of the keys of YAML, the same as the keys of JSON
+	// with pretty.TerminalStyle
+	colorKey = "\x1b[94m"
+)
+
+// statusColors are the colors of the values of the state and status
+// columns of the table format, indexed by lower cased value
+var statusColors = map[string]string{
+	"active":      colorGreen,
+	"allocated":   colorGreen,
+	"enabled":     colorGreen,
+	"implemented": colorGreen,
+	"ready":       colorGreen,
+	"running":     colorGreen,
+	"success":     colorGreen,
+	"allocating":  colorYellow,
+	"creating":    colorYellow,
+	"disabled":    colorYellow,
+	"migrating":   colorYellow,
+	"pending":     colorYellow,
+	"starting":    colorYellow,
+	"stopped":     colorYellow,
+	"stopping":    colorYellow,
+	"destroyed":   colorRed,
+	"error":       colorRed,
+	"expunging":   colorRed,
+	"failed":      colorRed,
+}
+
+// colorize returns 's' in 'color'
+func colorize(s string, color string) string {
+	return color + s + colorReset
+}
+
+// isStatusColumn returns true if the column holds a state or status,
+// e.g. "state", "status", "instanceState" or "vpnStatus"
+func isStatusColumn(column string) bool {
+	name := column[strings.LastIndex(column, ".")+1:]
+	lower := strings.ToLower(name)
+	return lower == "state" || lower == "status" || strings.HasSuffix(name, "State") || strings.HasSuffix(name, "Status")
+}
+
+// statusColor returns the color of the state or status 'value',
+// or an empty string if it is not colored
+func statusColor(value string) string {
+	return statusColors[strings.ToLower(value)]
+}
+
+// yamlKey matches the key of a mapping at the start of a line of YAML,
+// possibly as the first key of an element of a sequence
+var yamlKey = regexp.MustCompile(`^(\s*(?:- )*)([^\s'"#:-][^:]*|"[^"]*"|'[^']*'):( |$)`)
+
+// colorYAML returns the YAML document 'yamled' with its keys colored.
+// The lines of block scalars, i.e. multi-line strings, are left as is.
+func colorYAML(yamled []byte) []byte {
+	lines := strings.Split(string(yamled), "\n")
+	blockIndent := -1
+	for i, line := range lines {
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if blockIndent >= 0 {
+			if strings.TrimSpace(line) == "" || indent > blockIndent {
+				continue
+			}
+			blockIndent = -1
+		}
+		match := yamlKey.FindStringSubmatchIndex(line)
+		if match == nil {
+			continue
+		}
+		value := strings.TrimSpace(line[match[1]:])
+		if strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			blockIndent = match[3]
+		}
+		lines[i] = line[:match[4]] + colorize(line[match[4]:match[5]], colorKey) + line[match[5]:]
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
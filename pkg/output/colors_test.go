@@ -0,0 +1,82 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"testing"
+)
+
+func TestColorYAML(t *testing.T) {
+	key := func(k string) string { return colorize(k, colorKey) }
+	tests := []struct {
+		name     string
+		yamled   string
+		expected string
+	}{
+		{
+			"mapping",
+			"id: i1\nname: web-1\n",
+			key("id") + ": i1\n" + key("name") + ": web-1\n",
+		},
+		{
+			"nested mapping",
+			"organization:\n  entryPoint: acme\n",
+			key("organization") + ":\n  " + key("entryPoint") + ": acme\n",
+		},
+		{
+			"sequence of mappings",
+			"- id: i1\n  name: web-1\n",
+			"- " + key("id") + ": i1\n  " + key("name") + ": web-1\n",
+		},
+		{
+			"sequence of scalars",
+			"ips:\n- 10.0.0.1\n- http://example.com\n",
+			key("ips") + ":\n- 10.0.0.1\n- http://example.com\n",
+		},
+		{
+			"quoted value with colon",
+			"description: 'a: b'\n",
+			key("description") + ": 'a: b'\n",
+		},
+		{
+			"quoted key",
+			"\"a b\": c\n",
+			key("\"a b\"") + ": c\n",
+		},
+		{
+			"literal block scalar",
+			"userData: |-\n  #cloud-config\n  runcmd: [ls]\n  key: value\nname: web-1\n",
+			key("userData") + ": |-\n  #cloud-config\n  runcmd: [ls]\n  key: value\n" + key("name") + ": web-1\n",
+		},
+		{
+			"folded block scalar with empty line",
+			"a: >\n  x: y\n\n  z: w\nb: c\n",
+			key("a") + ": >\n  x: y\n\n  z: w\n" + key("b") + ": c\n",
+		},
+		{
+			"block scalar in sequence",
+			"- publicKey: |\n    k: v\n  name: n\n",
+			"- " + key("publicKey") + ": |\n    k: v\n  " + key("name") + ": n\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			colored := string(colorYAML([]byte(test.yamled)))
+			if colored != test.expected {
+				t.Errorf("colorYAML(%q) = %q, expected %q", test.yamled, colored, test.expected)
+			}
+		})
+	}
+}
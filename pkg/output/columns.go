@@ -0,0 +1,42 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+// defaultColumns are the curated columns of the table format for the
+// types having too many fields to show them all, indexed by type name.
+// The other types show all their fields which fit in a single cell.
+var defaultColumns = map[string][]string{
+	"cloudca.AffinityGroup":      {"id", "name", "type", "instanceNames"},
+	"cloudca.ComputeOffering":    {"id", "name", "cpuCount", "memoryInMB", "custom"},
+	"cloudca.Instance":           {"id", "name", "state", "computeOfferingName", "cpuCount", "memoryInMB", "ipAddress", "networkName", "templateName"},
+	"cloudca.LoadBalancerRule":   {"id", "name", "publicIp", "publicPort", "privatePort", "protocol", "algorithm", "stickinessMethod"},
+	"cloudca.Network":            {"id", "name", "state", "cidr", "gateway", "vpcId", "networkAclName"},
+	"cloudca.NetworkAclRule":     {"id", "ruleNumber", "action", "protocol", "trafficType", "cidr", "startPort", "endPort", "state"},
+	"cloudca.PortForwardingRule": {"id", "ipAddress", "publicPortStart", "publicPortEnd", "instanceName", "privateIp", "privatePortStart", "privatePortEnd", "protocol", "state"},
+	"cloudca.PublicIp":           {"id", "ipaddress", "state", "vpcName", "purposes", "ports", "instanceNames"},
+	"cloudca.RemoteAccessVpn":    {"id", "publicIpAddress", "state", "type"},
+	"cloudca.SSHKey":             {"id", "name", "fingerprint"},
+	"cloudca.Template":           {"id", "name", "osType", "size", "ready", "sshKeyEnabled", "passwordEnabled", "hypervisor"},
+	"cloudca.Volume":             {"id", "name", "type", "state", "sizeInGb", "diskOfferingName", "instanceName", "zoneName"},
+	"cloudca.Vpc":                {"id", "name", "state", "cidr", "zoneName", "sourceNatIp", "vpnStatus"},
+	"configuration.Environment":  {"id", "name", "description", "serviceConnection.serviceCode", "organization.entryPoint"},
+	"configuration.User":         {"id", "username", "organization.entryPoint"},
+}
+
+// documents are the types which are not tabular, e.g. the configuration
+// file, and are printed as YAML by the table format
+var documents = map[string]bool{
+	"config.Config": true,
+}
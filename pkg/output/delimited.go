@@ -0,0 +1,163 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// toCSV prints the input 'object', either a struct or a slice of
+// structs, to the output as comma separated values with one record per
+// struct. Nested structs are flattened into dotted column names, and the
+// values of the lists are JSON arrays.
+func (f *Formatter) toCSV(object interface{}, builder *Builder) error {
+	t, rows, ok := rows(object)
+	if !ok {
+		return fmt.Errorf("the csv output format only supports resources, use json or yaml instead")
+	}
+	columns, err := builder.delimitedColumns(t)
+	if err != nil {
+		return err
+	}
+	lists := listColumns(t, columns)
+	w := csv.NewWriter(f.out)
+	if !builder.opts.NoHeaders {
+		if err := w.Write(columns); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i] = delimitedValue(row, column, lists[i])
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// toTSV prints the input 'object', either a struct or a slice of
+// structs, to the output as tab separated values with one record per
+// struct. Nested structs are flattened into dotted column names, the
+// values of the lists are JSON arrays, and the tabs and line breaks of the
+// values are replaced by spaces.
+func (f *Formatter) toTSV(object interface{}, builder *Builder) error {
+	t, rows, ok := rows(object)
+	if !ok {
+		return fmt.Errorf("the tsv output format only supports resources, use json or yaml instead")
+	}
+	columns, err := builder.delimitedColumns(t)
+	if err != nil {
+		return err
+	}
+	lists := listColumns(t, columns)
+	replacer := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+	var b strings.Builder
+	if !builder.opts.NoHeaders {
+		b.WriteString(strings.Join(columns, "\t") + "\n")
+	}
+	for _, row := range rows {
+		for i, column := range columns {
+			if i > 0 {
+				b.WriteString("\t")
+			}
+			b.WriteString(replacer.Replace(delimitedValue(row, column, lists[i])))
+		}
+		b.WriteString("\n")
+	}
+	_, err = io.WriteString(f.out, b.String())
+	return err
+}
+
+// toNDJSON prints the input 'object' to the output as JSON on a single
+// line, or each of its elements on its own line if it is a slice
+func (f *Formatter) toNDJSON(object interface{}, builder *Builder) error {
+	v := reflect.Indirect(reflect.ValueOf(object))
+	values := []interface{}{object}
+	if v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) {
+		values = make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = v.Index(i).Interface()
+		}
+	}
+	encoder := json.NewEncoder(f.out)
+	for _, value := range values {
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delimitedColumns returns the columns selected with the --columns
+// flag, or all the flattened fields of struct type 't'
+func (b *Builder) delimitedColumns(t reflect.Type) ([]string, error) {
+	if len(b.opts.Columns) == 0 {
+		return flattenedFields(t), nil
+	}
+	return b.selectedColumns(t)
+}
+
+// listColumns returns whether each of the 'columns' of struct type 't'
+// can have several values
+func listColumns(t reflect.Type, columns []string) []bool {
+	lists := make([]bool, len(columns))
+	for i, column := range columns {
+		lists[i] = isList(t, column)
+	}
+	return lists
+}
+
+// delimitedValue returns the value of the field named by the dotted 'path'
+// in struct 'v'. The values of a list are encoded as a JSON array rather
+// than comma separated, as they can contain commas themselves, and an
+// empty list is an empty value.
+func delimitedValue(v reflect.Value, path string, list bool) string {
+	if !list {
+		return fieldValue(v, path)
+	}
+	values := []interface{}{}
+	for _, value := range lookup(v, path) {
+		value = reflect.Indirect(value)
+		if !value.IsValid() {
+			continue
+		}
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			values = append(values, value.Interface())
+			continue
+		}
+		for i := 0; i < value.Len(); i++ {
+			values = append(values, value.Index(i).Interface())
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(values); err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
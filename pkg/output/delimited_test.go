@@ -0,0 +1,199 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type delimitedNested struct {
+	Code string `json:"code"`
+}
+
+type delimitedItem struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description"`
+	Tags        []string          `json:"tags"`
+	Nested      delimitedNested   `json:"nested"`
+	Nesteds     []delimitedNested `json:"nesteds"`
+	private     string
+}
+
+var delimitedItems = []delimitedItem{
+	{
+		ID:          "i1",
+		Description: `say "hi", then	tab`,
+		Tags:        []string{"a", "b"},
+		Nested:      delimitedNested{Code: "x"},
+		Nesteds:     []delimitedNested{{Code: "y"}, {Code: "z"}},
+		private:     "hidden",
+	},
+	{
+		ID:          "i2",
+		Description: "multi\nline\r\nvalue",
+	},
+}
+
+func TestDelimited(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		object   interface{}
+		expected string
+	}{
+		{
+			"csv",
+			Options{Format: "csv"},
+			delimitedItems,
+			"id,description,tags,nested.code,nesteds.code\n" +
+				"i1,\"say \"\"hi\"\", then\ttab\",\"[\"\"a\"\",\"\"b\"\"]\",x,\"[\"\"y\"\",\"\"z\"\"]\"\n" +
+				"i2,\"multi\nline\r\nvalue\",,,\n",
+		},
+		{
+			"csv without headers",
+			Options{Format: "csv", NoHeaders: true, Columns: []string{"id", "nested.code"}},
+			delimitedItems,
+			"i1,x\ni2,\n",
+		},
+		{
+			"csv of a single struct",
+			Options{Format: "csv", Columns: []string{"id"}},
+			&delimitedItems[0],
+			"id\ni1\n",
+		},
+		{
+			"tsv",
+			Options{Format: "tsv"},
+			delimitedItems,
+			"id\tdescription\ttags\tnested.code\tnesteds.code\n" +
+				"i1\tsay \"hi\", then tab\t[\"a\",\"b\"]\tx\t[\"y\",\"z\"]\n" +
+				"i2\tmulti line value\t\t\t\n",
+		},
+		{
+			"tsv with columns",
+			Options{Format: "tsv", Columns: []string{"nesteds.code", "id"}, NoHeaders: true},
+			delimitedItems,
+			"[\"y\",\"z\"]\ti1\n\ti2\n",
+		},
+		{
+			"ndjson",
+			Options{Format: "ndjson"},
+			delimitedItems[:1],
+			`{"id":"i1","description":"say \"hi\", then\ttab","tags":["a","b"],"nested":{"code":"x"},"nesteds":[{"code":"y"},{"code":"z"}]}` + "\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			builder, err := NewBuilder(&buf, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = builder.Build(func(formatter *Formatter) error {
+				return formatter.Format(test.object)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expected {
+				t.Errorf("got %q, expected %q", buf.String(), test.expected)
+			}
+		})
+	}
+}
+
+func TestDelimitedLists(t *testing.T) {
+	items := []delimitedItem{
+		{
+			ID:      "i1",
+			Tags:    []string{"u1", "u2,x", "<u3>"},
+			Nesteds: []delimitedNested{{Code: "y,z"}, {Code: `"q"`}},
+		},
+		{
+			ID:   "i2",
+			Tags: []string{"single"},
+		},
+	}
+	for _, format := range []string{"csv", "tsv"} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			builder, err := NewBuilder(&buf, Options{Format: format, Columns: []string{"id", "tags", "nesteds.code"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = builder.Build(func(formatter *Formatter) error {
+				return formatter.Format(items)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := csv.NewReader(&buf)
+			if format == "tsv" {
+				r.Comma = '\t'
+				r.LazyQuotes = true
+			}
+			records, err := r.ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := [][]string{
+				{"id", "tags", "nesteds.code"},
+				{"i1", `["u1","u2,x","<u3>"]`, `["y,z","\"q\""]`},
+				{"i2", `["single"]`, ""},
+			}
+			if !reflect.DeepEqual(records, expected) {
+				t.Fatalf("got %q, expected %q", records, expected)
+			}
+			var tags []string
+			if err := json.Unmarshal([]byte(records[1][1]), &tags); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tags, items[0].Tags) {
+				t.Errorf("got tags %q, expected %q", tags, items[0].Tags)
+			}
+		})
+	}
+}
+
+func TestDelimitedErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   Options
+		object interface{}
+	}{
+		{"unknown column", Options{Format: "csv", Columns: []string{"nope"}}, delimitedItems},
+		{"unknown nested column", Options{Format: "tsv", Columns: []string{"nested.nope"}}, delimitedItems},
+		{"not a resource", Options{Format: "csv"}, map[string]string{"a": "b"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			builder, err := NewBuilder(&buf, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = builder.Build(func(formatter *Formatter) error {
+				return formatter.Format(test.object)
+			})
+			if err == nil {
+				t.Errorf("formatting succeeded with %q, expected an error", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// fieldName returns the name of the struct field as found in the JSON
+// representation, and false if the field is not part of it
+func fieldName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "", false
+	}
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	if tag == "-" {
+		return "", false
+	}
+	if tag == "" {
+		return field.Name, true
+	}
+	return tag, true
+}
+
+// indirectType returns the type pointed to by 't', if it is a pointer
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// structType returns the struct type of the values of type 't', or
+// of their elements if 't' is a slice, and false if there is none
+func structType(t reflect.Type) (reflect.Type, bool) {
+	t = indirectType(t)
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = indirectType(t.Elem())
+	}
+	return t, t.Kind() == reflect.Struct
+}
+
+// rows returns the struct type of input 'object' and its rows, i.e.
+// the elements of 'object' if it is a slice of structs, or 'object'
+// itself if it is a struct. False is returned for other objects.
+func rows(object interface{}) (reflect.Type, []reflect.Value, bool) {
+	v := reflect.Indirect(reflect.ValueOf(object))
+	if !v.IsValid() {
+		return nil, nil, false
+	}
+	t, ok := structType(v.Type())
+	if !ok || documents[t.String()] {
+		return nil, nil, false
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return t, []reflect.Value{v}, true
+	}
+	values := make([]reflect.Value, v.Len())
+	for i := range values {
+		values[i] = v.Index(i)
+	}
+	return t, values, true
+}
+
+// isScalar returns true if values of type 't' fit in a single cell
+func isScalar(t reflect.Type) bool {
+	switch indirectType(t).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Interface, reflect.Array, reflect.Func, reflect.Chan:
+		return false
+	case reflect.Slice:
+		return isScalar(t.Elem())
+	}
+	return true
+}
+
+// scalarFields returns the names of the fields of struct type 't' whose
+// values fit in a single cell, in their order of declaration
+func scalarFields(t reflect.Type) []string {
+	names := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := fieldName(field)
+		if ok && isScalar(field.Type) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// flattenedFields returns the dotted names of all the fields of struct
+// type 't' and of its nested structs, e.g. "organization.entryPoint".
+// Only the slices of structs of 't' itself are flattened, and the fields
+// of a struct type already found in the path to it are left out, to stop
+// at the references back to their parents.
+func flattenedFields(t reflect.Type) []string {
+	return flatten(t, "", map[reflect.Type]bool{t: true})
+}
+
+func flatten(t reflect.Type, prefix string, parents map[reflect.Type]bool) []string {
+	names := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+		nested, isStruct := structType(field.Type)
+		if !isStruct {
+			names = append(names, prefix+name)
+			continue
+		}
+		isSlice := indirectType(field.Type).Kind() != reflect.Struct
+		if parents[nested] || (isSlice && prefix != "") {
+			continue
+		}
+		parents[nested] = true
+		names = append(names, flatten(nested, prefix+name+".", parents)...)
+		delete(parents, nested)
+	}
+	return names
+}
+
+// fieldIndex returns the index of the field of struct type 't' named 'name'
+func fieldIndex(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if n, ok := fieldName(t.Field(i)); ok && n == name {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// checkPath returns an error if the dotted 'path' does not name a field
+// of struct type 't' or of its nested structs and slices of structs
+func checkPath(t reflect.Type, path string) error {
+	current := t
+	for _, name := range strings.Split(path, ".") {
+		st, ok := structType(current)
+		if !ok {
+			return unknownField(t, path)
+		}
+		i, ok := fieldIndex(st, name)
+		if !ok {
+			return unknownField(t, path)
+		}
+		current = st.Field(i).Type
+	}
+	return nil
+}
+
+// isList returns true if the field named by the dotted 'path' in struct
+// type 't' can have several values, i.e. if it is a slice or is nested in one
+func isList(t reflect.Type, path string) bool {
+	current := indirectType(t)
+	for _, name := range strings.Split(path, ".") {
+		if current.Kind() == reflect.Slice || current.Kind() == reflect.Array {
+			return true
+		}
+		if current.Kind() != reflect.Struct {
+			return false
+		}
+		i, ok := fieldIndex(current, name)
+		if !ok {
+			return false
+		}
+		current = indirectType(current.Field(i).Type)
+	}
+	return current.Kind() == reflect.Slice || current.Kind() == reflect.Array
+}
+
+// unknownField returns the error of a 'path' which is not a field of struct
+// type 't', listing the dotted names of its fields and nested ones
+func unknownField(t reflect.Type, path string) error {
+	fields := flattenedFields(t)
+	if len(fields) == 0 {
+		return fmt.Errorf("unknown field '%s', no fields available", path)
+	}
+	return fmt.Errorf("unknown field '%s', available fields: %s", path, strings.Join(fields, ", "))
+}
+
+// lookup returns the values of the field named by the dotted 'path' in
+// struct 'v'. Nested slices of structs yield the field of each element.
+func lookup(v reflect.Value, path string) []reflect.Value {
+	values := []reflect.Value{v}
+	for _, name := range strings.Split(path, ".") {
+		next := []reflect.Value{}
+		for _, value := range values {
+			value = reflect.Indirect(value)
+			if !value.IsValid() {
+				continue
+			}
+			if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+				for i := 0; i < value.Len(); i++ {
+					next = append(next, lookup(value.Index(i), name)...)
+				}
+				continue
+			}
+			if value.Kind() != reflect.Struct {
+				continue
+			}
+			if i, ok := fieldIndex(value.Type(), name); ok {
+				next = append(next, value.Field(i))
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+// fieldValue returns the string representation of the field named by
+// the dotted 'path' in struct 'v', multiple values being comma separated
+func fieldValue(v reflect.Value, path string) string {
+	values := lookup(v, path)
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		if s := formatValue(value); s != "" {
+			formatted = append(formatted, s)
+		}
+	}
+	return strings.Join(formatted, ",")
+}
+
+// formatValue returns the string representation of 'v', the elements of
+// slices being comma separated and structs being represented as JSON
+func formatValue(v reflect.Value) string {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Slice, reflect.Array:
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(values, ",")
+	case reflect.Map:
+		values := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			values = append(values, fmt.Sprintf("%v=%s", key.Interface(), formatValue(v.MapIndex(key))))
+		}
+		sort.Strings(values)
+		return strings.Join(values, ",")
+	case reflect.Struct:
+		jsoned, err := json.Marshal(v.Interface())
+		if err != nil {
+			return ""
+		}
+		return string(jsoned)
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -16,51 +16,116 @@ package output
 
 import (
 	"encoding/json"
-	"fmt"
+	"io"
 
 	"github.com/tidwall/pretty"
 	yaml "gopkg.in/yaml.v2"
 )
 
 // Formatter is used to format retrieved object to selected
-// format and print it out on STDOUT and also colorized it
+// format and print it out on its writer and also colorized it
 // if the flag is set.
 type Formatter struct {
 	builder *Builder
+	out     io.Writer
+
+	// columns replace the default columns of the table format
+	columns []string
+}
+
+// FormatColumns prints 'object' like Format, with 'columns' replacing the
+// default columns of the table format when none are selected with --columns.
+// It shows fields left out of the defaults on purpose, e.g. secrets, in the
+// output of the commands meant to reveal them.
+func (f *Formatter) FormatColumns(object interface{}, columns ...string) error {
+	f.columns = columns
+	defer func() { f.columns = nil }()
+	return f.Format(object)
 }
 
 // Format prints the representation of input 'object' to
-// STDOUT based on the requested 'format' (JSON or YAML).
-// The output will also be colorized if flag is set.
+// the output based on the requested 'format' (JSON, YAML, table,
+// CSV, TSV, NDJSON, JSONPath or Go template). The output will
+// also be colorized if flag is set.
 func (f *Formatter) Format(object interface{}) error {
 	builder := f.builder
-	if builder.format == "json" {
+	switch builder.format {
+	case "json":
 		return f.toJSON(object, builder)
-	} else if builder.format == "yaml" {
+	case "yaml":
 		return f.toYAML(object, builder)
+	case "table":
+		return f.toTable(object, builder)
+	case "csv":
+		return f.toCSV(object, builder)
+	case "tsv":
+		return f.toTSV(object, builder)
+	case "ndjson":
+		return f.toNDJSON(object, builder)
+	case "jsonpath":
+		return f.toJSONPath(object, builder)
+	case "go-template":
+		return f.toGoTemplate(object, builder)
 	}
 	return nil
 }
 
+// Text prints 'text' as is to the output, whatever the format. It is
+// used by the commands whose result is not a resource, e.g. a setting
+// or a configuration file, so that it honors --output-file.
+func (f *Formatter) Text(text string) error {
+	_, err := io.WriteString(f.out, text)
+	return err
+}
+
 // toJSON prints the JSON representation of input 'object'
-// to STDOUT. The output will be colorized if flag is set.
+// to the output. The output will be colorized if flag is set.
 func (f *Formatter) toJSON(object interface{}, builder *Builder) error {
 	jsoned, err := json.Marshal(object)
 	if err != nil {
 		return err
 	}
 	jsoned = pretty.Pretty(jsoned)
-	_, err = fmt.Printf("%s", jsoned)
+	if builder.opts.Color {
+		jsoned = pretty.Color(jsoned, pretty.TerminalStyle)
+	}
+	_, err = f.out.Write(jsoned)
 	return err
 }
 
 // toYAML prints the YAML representation of input 'object'
-// to STDOUT. The output will be colorized if flag is set.
+// to the output. The output will be colorized if flag is set.
 func (f *Formatter) toYAML(object interface{}, builder *Builder) error {
 	yamled, err := yaml.Marshal(object)
 	if err != nil {
 		return err
 	}
-	_, err = fmt.Printf("%s", yamled)
+	if builder.opts.Color {
+		yamled = colorYAML(yamled)
+	}
+	_, err = f.out.Write(yamled)
 	return err
 }
+
+// toJSONPath prints the result of the JSONPath template applied
+// to the JSON representation of input 'object' to the output
+func (f *Formatter) toJSONPath(object interface{}, builder *Builder) error {
+	jsoned, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	if err := json.Unmarshal(jsoned, &data); err != nil {
+		return err
+	}
+	if items, ok := data.([]interface{}); ok {
+		data = map[string]interface{}{"items": items}
+	}
+	return builder.jsonPath.execute(f.out, data)
+}
+
+// toGoTemplate prints the result of the Go template applied
+// to input 'object' to the output
+func (f *Formatter) toGoTemplate(object interface{}, builder *Builder) error {
+	return builder.goTemplate.Execute(f.out, object)
+}
@@ -0,0 +1,499 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a parsed template of the jsonpath output format, which
+// implements the subset of JSONPath supported by kubectl:
+//
+//   - text outside of curly braces is printed as is
+//   - {.a.b}, {.a['b']} and {.a.*} select fields, {@} the current object and {$} the root one
+//   - {.a[0]}, {.a[-1]}, {.a[1:3]} and {.a[*]} select elements of arrays
+//   - {.a[?(@.b=="c")]} filters elements of arrays with == or !=
+//   - {range .a[*]}...{end} repeats the template for each selected element
+//   - {"\t"} prints a quoted string literal
+//
+// The template is applied to the JSON representation of the object, where
+// lists are wrapped in the "items" field of an object as kubectl does.
+type jsonPath struct {
+	nodes []jsonPathNode
+}
+
+// jsonPathNode is a node of a template, either text to print as is,
+// a path to print the selected values of, or a range over a path
+type jsonPathNode struct {
+	kind string // "text", "path" or "range"
+	text string
+	path []jsonPathStep
+	body []jsonPathNode
+}
+
+// jsonPathStep selects values from the values selected by the previous step
+type jsonPathStep struct {
+	kind     string // "root", "field", "wildcard", "index", "slice" or "filter"
+	name     string
+	index    int
+	start    *int
+	end      *int
+	filter   []jsonPathStep
+	operator string
+	operand  interface{}
+}
+
+// parseJSONPath parses the template of the jsonpath output format
+func parseJSONPath(template string) (*jsonPath, error) {
+	stack := [][]jsonPathNode{{}}
+	ranges := []jsonPathNode{}
+	rest := template
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{kind: "text", text: rest})
+			break
+		}
+		if open > 0 {
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{kind: "text", text: rest[:open]})
+		}
+		close := closingBrace(rest, open)
+		if close < 0 {
+			return nil, fmt.Errorf("invalid jsonpath template %s: unclosed '{'", template)
+		}
+		expr := strings.TrimSpace(rest[open+1 : close])
+		rest = rest[close+1:]
+
+		switch {
+		case expr == "end":
+			if len(ranges) == 0 {
+				return nil, fmt.Errorf("invalid jsonpath template %s: {end} without {range}", template)
+			}
+			rng := ranges[len(ranges)-1]
+			rng.body = stack[len(stack)-1]
+			ranges = ranges[:len(ranges)-1]
+			stack = stack[:len(stack)-1]
+			stack[len(stack)-1] = append(stack[len(stack)-1], rng)
+		case strings.HasPrefix(expr, "range ") || strings.HasPrefix(expr, "range\t"):
+			path, err := parseJSONPathExpr(strings.TrimSpace(expr[len("range"):]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath template %s: %s", template, err)
+			}
+			ranges = append(ranges, jsonPathNode{kind: "range", path: path})
+			stack = append(stack, []jsonPathNode{})
+		case strings.HasPrefix(expr, `"`):
+			text, err := strconv.Unquote(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath template %s: invalid string %s", template, expr)
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{kind: "text", text: text})
+		default:
+			path, err := parseJSONPathExpr(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath template %s: %s", template, err)
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{kind: "path", path: path})
+		}
+	}
+	if len(ranges) > 0 {
+		return nil, fmt.Errorf("invalid jsonpath template %s: {range} without {end}", template)
+	}
+	return &jsonPath{nodes: stack[0]}, nil
+}
+
+// closingBrace returns the index of the brace closing the one at
+// index 'open' of 's', ignoring the braces in quoted strings
+func closingBrace(s string, open int) int {
+	var quote byte
+	for i := open + 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseJSONPathExpr parses a path like .a.b[0] into its steps
+func parseJSONPathExpr(expr string) ([]jsonPathStep, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("empty expression")
+	}
+	steps := []jsonPathStep{}
+	if expr[0] == '$' {
+		steps = append(steps, jsonPathStep{kind: "root"})
+		expr = expr[1:]
+	} else if expr[0] == '@' {
+		expr = expr[1:]
+	}
+	for expr != "" {
+		switch expr[0] {
+		case '.':
+			expr = expr[1:]
+			if expr == "" {
+				return steps, nil
+			}
+			if expr[0] == '*' {
+				steps = append(steps, jsonPathStep{kind: "wildcard"})
+				expr = expr[1:]
+				continue
+			}
+			end := strings.IndexAny(expr, ".[")
+			if end < 0 {
+				end = len(expr)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid expression: missing field name before '%c'", expr[0])
+			}
+			steps = append(steps, jsonPathStep{kind: "field", name: expr[:end]})
+			expr = expr[end:]
+		case '[':
+			close := closingBracket(expr)
+			if close < 0 {
+				return nil, fmt.Errorf("invalid expression: unclosed '['")
+			}
+			step, err := parseJSONPathSubscript(strings.TrimSpace(expr[1:close]))
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			expr = expr[close+1:]
+		default:
+			return nil, fmt.Errorf("invalid expression: unexpected '%c', paths start with '.'", expr[0])
+		}
+	}
+	return steps, nil
+}
+
+// closingBracket returns the index of the bracket closing the one
+// at the start of 's', ignoring the brackets in quoted strings
+func closingBracket(s string) int {
+	var quote byte
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseJSONPathSubscript parses the content of brackets
+func parseJSONPathSubscript(subscript string) (jsonPathStep, error) {
+	switch {
+	case subscript == "*":
+		return jsonPathStep{kind: "wildcard"}, nil
+	case strings.HasPrefix(subscript, "?(") && strings.HasSuffix(subscript, ")"):
+		return parseJSONPathFilter(strings.TrimSpace(subscript[2 : len(subscript)-1]))
+	case strings.HasPrefix(subscript, "'") || strings.HasPrefix(subscript, `"`):
+		name, err := unquote(subscript)
+		if err != nil {
+			return jsonPathStep{}, fmt.Errorf("invalid field name %s", subscript)
+		}
+		return jsonPathStep{kind: "field", name: name}, nil
+	case strings.Contains(subscript, ":"):
+		parts := strings.SplitN(subscript, ":", 2)
+		step := jsonPathStep{kind: "slice"}
+		for i, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return jsonPathStep{}, fmt.Errorf("invalid array slice [%s]", subscript)
+			}
+			if i == 0 {
+				step.start = &n
+			} else {
+				step.end = &n
+			}
+		}
+		return step, nil
+	}
+	n, err := strconv.Atoi(subscript)
+	if err != nil {
+		return jsonPathStep{}, fmt.Errorf("invalid array index [%s]", subscript)
+	}
+	return jsonPathStep{kind: "index", index: n}, nil
+}
+
+// parseJSONPathFilter parses a filter like @.a=="b", or @.a testing
+// that the field exists and is neither false nor null
+func parseJSONPathFilter(filter string) (jsonPathStep, error) {
+	step := jsonPathStep{kind: "filter"}
+	path := filter
+	if i := filterOperator(filter); i >= 0 {
+		path = strings.TrimSpace(filter[:i])
+		operand, err := parseJSONPathOperand(strings.TrimSpace(filter[i+2:]))
+		if err != nil {
+			return jsonPathStep{}, err
+		}
+		step.operator = filter[i : i+2]
+		step.operand = operand
+	}
+	if !strings.HasPrefix(path, "@") {
+		return jsonPathStep{}, fmt.Errorf("invalid filter %s: paths of filters start with '@'", filter)
+	}
+	steps, err := parseJSONPathExpr(path)
+	if err != nil {
+		return jsonPathStep{}, err
+	}
+	step.filter = steps
+	return step, nil
+}
+
+// filterOperator returns the index of the first == or != operator of
+// 'filter' outside of quoted strings, or -1 if there is none
+func filterOperator(filter string) int {
+	var quote byte
+	for i := 0; i < len(filter); i++ {
+		c := filter[i]
+		switch {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+		case c == '"' || c == '\'':
+			quote = c
+		case (c == '=' || c == '!') && i+1 < len(filter) && filter[i+1] == '=':
+			return i
+		}
+	}
+	return -1
+}
+
+// parseJSONPathOperand parses the quoted string, number, boolean
+// or null literal on the right hand side of a filter
+func parseJSONPathOperand(operand string) (interface{}, error) {
+	if strings.HasPrefix(operand, "'") || strings.HasPrefix(operand, `"`) {
+		return unquote(operand)
+	}
+	switch operand {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(operand, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter value %s, strings must be quoted", operand)
+	}
+	return n, nil
+}
+
+// unquote returns the content of the single or double quoted string 's'
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'") && len(s) >= 2 {
+		s = `"` + strings.Replace(s[1:len(s)-1], `"`, `\"`, -1) + `"`
+	}
+	return strconv.Unquote(s)
+}
+
+// execute prints the template applied to 'data' to 'w'
+func (jp *jsonPath) execute(w io.Writer, data interface{}) error {
+	return executeJSONPath(w, jp.nodes, data, data)
+}
+
+func executeJSONPath(w io.Writer, nodes []jsonPathNode, root, current interface{}) error {
+	for _, node := range nodes {
+		if node.kind == "text" {
+			if _, err := io.WriteString(w, node.text); err != nil {
+				return err
+			}
+			continue
+		}
+		values := selectJSONPath(node.path, root, current)
+		if node.kind == "range" {
+			if len(values) == 1 {
+				if elements, ok := values[0].([]interface{}); ok {
+					values = elements
+				}
+			}
+			for _, value := range values {
+				if err := executeJSONPath(w, node.body, root, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		printed := make([]string, len(values))
+		for i, value := range values {
+			printed[i] = jsonPathValue(value)
+		}
+		if _, err := io.WriteString(w, strings.Join(printed, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectJSONPath returns the values selected by 'steps' from 'current'
+func selectJSONPath(steps []jsonPathStep, root, current interface{}) []interface{} {
+	values := []interface{}{current}
+	for _, step := range steps {
+		selected := []interface{}{}
+		for _, value := range values {
+			selected = append(selected, step.apply(root, value)...)
+		}
+		values = selected
+	}
+	return values
+}
+
+// apply returns the values selected by the step from 'value'
+func (s jsonPathStep) apply(root, value interface{}) []interface{} {
+	switch s.kind {
+	case "root":
+		return []interface{}{root}
+	case "field":
+		if object, ok := value.(map[string]interface{}); ok {
+			if field, ok := object[s.name]; ok {
+				return []interface{}{field}
+			}
+		}
+	case "wildcard":
+		switch v := value.(type) {
+		case []interface{}:
+			return v
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			fields := make([]interface{}, len(keys))
+			for i, key := range keys {
+				fields[i] = v[key]
+			}
+			return fields
+		}
+	case "index":
+		if array, ok := value.([]interface{}); ok {
+			i := s.index
+			if i < 0 {
+				i += len(array)
+			}
+			if i >= 0 && i < len(array) {
+				return []interface{}{array[i]}
+			}
+		}
+	case "slice":
+		if array, ok := value.([]interface{}); ok {
+			start, end := bound(s.start, 0, len(array)), bound(s.end, len(array), len(array))
+			if start < end {
+				return array[start:end]
+			}
+		}
+	case "filter":
+		if array, ok := value.([]interface{}); ok {
+			matches := []interface{}{}
+			for _, element := range array {
+				if s.matches(root, element) {
+					matches = append(matches, element)
+				}
+			}
+			return matches
+		}
+	}
+	return nil
+}
+
+// bound returns the index 'n' of an array of length 'length', counted from
+// its end if negative and clamped to its bounds, or 'dflt' if not provided
+func bound(n *int, dflt int, length int) int {
+	if n == nil {
+		return dflt
+	}
+	i := *n
+	if i < 0 {
+		i += length
+	}
+	if i < 0 {
+		return 0
+	}
+	if i > length {
+		return length
+	}
+	return i
+}
+
+// matches returns true if 'element' is selected by the filter step
+func (s jsonPathStep) matches(root, element interface{}) bool {
+	values := selectJSONPath(s.filter, root, element)
+	if s.operator == "" {
+		return len(values) > 0 && values[0] != nil && values[0] != false
+	}
+	equal := false
+	if len(values) > 0 {
+		equal = jsonPathValue(values[0]) == jsonPathValue(s.operand)
+	} else {
+		equal = s.operand == nil
+	}
+	if s.operator == "!=" {
+		return !equal
+	}
+	return equal
+}
+
+// jsonPathValue returns the printed representation of a selected value,
+// strings being unquoted and objects and arrays printed as JSON
+func jsonPathValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	jsoned, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(jsoned)
+}
@@ -0,0 +1,111 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+const jsonPathData = `{
+	"items": [
+		{"id": "i1", "name": "web-1", "state": "Running", "cpu": 2, "tags": {"a.b": "x", "env": "dev"}, "ips": ["10.0.0.1", "10.0.0.2"]},
+		{"id": "i2", "name": "a==b", "state": "Stopped", "cpu": 4, "ips": []},
+		{"id": "i3", "name": "a!=b", "state": "Running", "cpu": 1, "ready": true}
+	]
+}`
+
+func TestJSONPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"field", `{.items[0].name}`, "web-1"},
+		{"root", `{$.items[0].id}`, "i1"},
+		{"text around expressions", `id: {.items[0].id}!`, "id: i1!"},
+		{"wildcard", `{.items[*].id}`, "i1 i2 i3"},
+		{"dot wildcard", `{.items[0].tags.*}`, "x dev"},
+		{"index", `{.items[1].id}`, "i2"},
+		{"negative index", `{.items[-1].id}`, "i3"},
+		{"out of range index", `{.items[5].id}`, ""},
+		{"slice", `{.items[0:2].id}`, "i1 i2"},
+		{"open slice", `{.items[1:].id}`, "i2 i3"},
+		{"negative slice", `{.items[-2:].id}`, "i2 i3"},
+		{"quoted key", `{.items[0].tags['a.b']}`, "x"},
+		{"double quoted key", `{.items[0].tags["env"]}`, "dev"},
+		{"number", `{.items[1].cpu}`, "4"},
+		{"array", `{.items[0].ips}`, `["10.0.0.1","10.0.0.2"]`},
+		{"string literal", `{.items[0].id}{"\t"}{.items[1].id}{"\n"}`, "i1\ti2\n"},
+		{"range", `{range .items[*]}{.id}:{.state}{"\n"}{end}`, "i1:Running\ni2:Stopped\ni3:Running\n"},
+		{"range without wildcard", `{range .items}{.id},{end}`, "i1,i2,i3,"},
+		{"nested range", `{range .items[*]}{range .ips[*]}{@} {end}{end}`, "10.0.0.1 10.0.0.2 "},
+		{"root in range", `{range .items[0:1]}{$.items[2].id}{end}`, "i3"},
+		{"filter equal", `{.items[?(@.state=="Running")].id}`, "i1 i3"},
+		{"filter not equal", `{.items[?(@.state!="Running")].id}`, "i2"},
+		{"filter single quotes", `{.items[?(@.state=='Stopped')].id}`, "i2"},
+		{"filter number", `{.items[?(@.cpu==4)].id}`, "i2"},
+		{"filter existence", `{.items[?(@.ready)].id}`, "i3"},
+		{"filter equal operator in operand", `{.items[?(@.name=="a!=b")].id}`, "i3"},
+		{"filter not equal operator in operand", `{.items[?(@.name!="a==b")].id}`, "i1 i3"},
+		{"filter in range", `{range .items[?(@.cpu!=4)]}{.name}{"\n"}{end}`, "web-1\na!=b\n"},
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(jsonPathData), &data); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jp, err := parseJSONPath(test.template)
+			if err != nil {
+				t.Fatalf("parseJSONPath(%q) failed: %s", test.template, err)
+			}
+			var buf bytes.Buffer
+			if err := jp.execute(&buf, data); err != nil {
+				t.Fatalf("execute(%q) failed: %s", test.template, err)
+			}
+			if buf.String() != test.expected {
+				t.Errorf("execute(%q) = %q, expected %q", test.template, buf.String(), test.expected)
+			}
+		})
+	}
+}
+
+func TestJSONPathErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{"unclosed brace", `{.items`},
+		{"range without end", `{range .items[*]}{.id}`},
+		{"end without range", `{.id}{end}`},
+		{"unclosed bracket", `{.items[0}`},
+		{"invalid index", `{.items[a]}`},
+		{"invalid slice", `{.items[1:b]}`},
+		{"invalid string", `{"\q"}`},
+		{"missing field name", `{.items..id}`},
+		{"path without dot", `{items}`},
+		{"filter without @", `{.items[?(.state=="Running")]}`},
+		{"unquoted filter value", `{.items[?(@.state==Running)]}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := parseJSONPath(test.template); err == nil {
+				t.Errorf("parseJSONPath(%q) succeeded, expected an error", test.template)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// none is the value of the empty cells of the table format, so that
+// every column of a row has a value when split on whitespaces
+const none = "<none>"
+
+// toTable prints the input 'object', either a struct or a slice of
+// structs, as aligned columns to the output with one row per struct. The
+// objects which are not tabular are printed as YAML instead.
+func (f *Formatter) toTable(object interface{}, builder *Builder) error {
+	t, rows, ok := rows(object)
+	if !ok {
+		return f.toYAML(object, builder)
+	}
+	columns, err := builder.tableColumns(t, f.columns)
+	if err != nil {
+		return err
+	}
+	table := [][]string{}
+	if !builder.opts.NoHeaders {
+		headers := make([]string, len(columns))
+		for i, column := range columns {
+			headers[i] = header(column)
+		}
+		table = append(table, headers)
+	}
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for i, column := range columns {
+			cell := strings.Join(strings.Fields(fieldValue(row, column)), " ")
+			if cell == "" {
+				cell = none
+			}
+			cells[i] = cell
+		}
+		table = append(table, cells)
+	}
+
+	// the columns are aligned here rather than with text/tabwriter,
+	// which would count the escape sequences of colors in the widths
+	widths := make([]int, len(columns))
+	for _, cells := range table {
+		for i, cell := range cells {
+			if width := utf8.RuneCountInString(cell); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+	var b strings.Builder
+	for r, cells := range table {
+		isHeader := r == 0 && !builder.opts.NoHeaders
+		for i, cell := range cells {
+			padding := ""
+			if i+1 < len(cells) {
+				padding = strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+3)
+			}
+			if builder.opts.Color && !isHeader && isStatusColumn(columns[i]) {
+				if color := statusColor(cell); color != "" {
+					cell = colorize(cell, color)
+				}
+			}
+			b.WriteString(cell + padding)
+		}
+		b.WriteString("\n")
+	}
+	_, err = io.WriteString(f.out, b.String())
+	return err
+}
+
+// tableColumns returns the columns selected with the --columns flag,
+// or 'defaults' if any, or the curated default columns of struct type 't'
+func (b *Builder) tableColumns(t reflect.Type, defaults []string) ([]string, error) {
+	if len(b.opts.Columns) == 0 {
+		if len(defaults) > 0 {
+			return defaults, nil
+		}
+		if columns, ok := defaultColumns[t.String()]; ok {
+			return columns, nil
+		}
+		return scalarFields(t), nil
+	}
+	return b.selectedColumns(t)
+}
+
+// selectedColumns returns the columns selected with the --columns
+// flag, or an error if one is not a field of struct type 't'
+func (b *Builder) selectedColumns(t reflect.Type) ([]string, error) {
+	columns := make([]string, len(b.opts.Columns))
+	for i, column := range b.opts.Columns {
+		column = strings.TrimSpace(column)
+		if err := checkPath(t, column); err != nil {
+			return nil, err
+		}
+		columns[i] = column
+	}
+	return columns, nil
+}
+
+// header returns the upper case header of 'column', with its camel
+// cased and dotted words separated, e.g. "MEMORY IN MB" for "memoryInMB"
+func header(column string) string {
+	runes := []rune(column)
+	var b strings.Builder
+	for i, r := range runes {
+		if r == '.' || r == '-' || r == '_' {
+			b.WriteRune(' ')
+			continue
+		}
+		if i > 0 && unicode.IsUpper(r) {
+			previous := runes[i-1]
+			// a trailing lower case 's' pluralizes an acronym, e.g. "publicIPs"
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) &&
+				!(runes[i+1] == 's' && (i+2 == len(runes) || !unicode.IsLetter(runes[i+2])))
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				b.WriteRune(' ')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,242 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cloud-ca/cca/pkg/config"
+	"github.com/cloud-ca/go-cloudca/configuration"
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+func TestHeader(t *testing.T) {
+	tests := []struct {
+		column   string
+		expected string
+	}{
+		{"id", "ID"},
+		{"memoryInMB", "MEMORY IN MB"},
+		{"publicIPs", "PUBLIC IPS"},
+		{"publicIPs.ipaddress", "PUBLIC IPS IPADDRESS"},
+		{"ipAddress", "IP ADDRESS"},
+		{"sizeInGb", "SIZE IN GB"},
+		{"organization.entryPoint", "ORGANIZATION ENTRY POINT"},
+		{"serviceConnection.serviceCode", "SERVICE CONNECTION SERVICE CODE"},
+		{"api-url", "API URL"},
+		{"current_profile", "CURRENT PROFILE"},
+		{"vpcID", "VPC ID"},
+		{"HTTPServer", "HTTP SERVER"},
+	}
+	for _, test := range tests {
+		t.Run(test.column, func(t *testing.T) {
+			if h := header(test.column); h != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, h)
+			}
+		})
+	}
+}
+
+var tableInstances = []cloudca.Instance{
+	{
+		Id:                  "i1",
+		Name:                "web-1",
+		State:               "Running",
+		ComputeOfferingName: "Standard",
+		CpuCount:            2,
+		MemoryInMB:          4096,
+		IpAddress:           "10.0.0.1",
+		NetworkName:         "web",
+		TemplateName:        "Ubuntu 18.04",
+		PublicIps:           []cloudca.PublicIp{{IpAddress: "203.0.113.4"}, {IpAddress: "203.0.113.5"}},
+		RecoveryPoint:       cloudca.RecoveryPoint{Name: "daily"},
+	},
+	{
+		Id:    "i2",
+		Name:  "db-1",
+		State: "Stopped",
+	},
+}
+
+func TestTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		object   interface{}
+		expected string
+	}{
+		{
+			"default columns",
+			Options{Format: "table"},
+			tableInstances,
+			"ID   NAME    STATE     COMPUTE OFFERING NAME   CPU COUNT   MEMORY IN MB   IP ADDRESS   NETWORK NAME   TEMPLATE NAME\n" +
+				"i1   web-1   Running   Standard                2           4096           10.0.0.1     web            Ubuntu 18.04\n" +
+				"i2   db-1    Stopped   <none>                  0           0              <none>       <none>         <none>\n",
+		},
+		{
+			"selected columns",
+			Options{Format: "table", Columns: []string{"name", " publicIPs.ipaddress", "recoveryPoint.name"}},
+			tableInstances,
+			"NAME    PUBLIC IPS IPADDRESS      RECOVERY POINT NAME\n" +
+				"web-1   203.0.113.4,203.0.113.5   daily\n" +
+				"db-1    <none>                    <none>\n",
+		},
+		{
+			"no headers",
+			Options{Format: "table", Columns: []string{"id", "state"}, NoHeaders: true},
+			tableInstances,
+			"i1   Running\n" +
+				"i2   Stopped\n",
+		},
+		{
+			"single struct",
+			Options{Format: "table"},
+			&cloudca.SSHKey{ID: "k1", Name: "laptop"},
+			"ID   NAME     FINGERPRINT\n" +
+				"k1   laptop   <none>\n",
+		},
+		{
+			"empty slice",
+			Options{Format: "table"},
+			[]cloudca.Instance{},
+			"ID   NAME   STATE   COMPUTE OFFERING NAME   CPU COUNT   MEMORY IN MB   IP ADDRESS   NETWORK NAME   TEMPLATE NAME\n",
+		},
+		{
+			"multi-line cell",
+			Options{Format: "table", Columns: []string{"id", "name"}},
+			[]cloudca.Instance{{Id: "i3", Name: "multi\nline  name"}},
+			"ID   NAME\n" +
+				"i3   multi line name\n",
+		},
+		{
+			"configuration file",
+			Options{Format: "table"},
+			&config.Config{CurrentProfile: "staging", Profiles: map[string]*config.Profile{"staging": {Environment: "dev"}}},
+			"current-profile: staging\n" +
+				"profiles:\n" +
+				"  staging:\n" +
+				"    environment: dev\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			builder, err := NewBuilder(&buf, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = builder.Build(func(formatter *Formatter) error {
+				return formatter.Format(test.object)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestTableColumnsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		err     string
+	}{
+		{"unknown field", []string{"id", "nope"}, "unknown field 'nope'"},
+		{"unknown nested field", []string{"recoveryPoint.nope"}, "unknown field 'recoveryPoint.nope'"},
+		{"field of a scalar", []string{"name.length"}, "unknown field 'name.length'"},
+		{"lists nested fields", []string{"nope"}, "publicIPs.ipaddress"},
+		{"lists nested struct fields", []string{"nope"}, "recoveryPoint.name"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			builder, err := NewBuilder(&bytes.Buffer{}, Options{Format: "table", Columns: test.columns})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = builder.Build(func(formatter *Formatter) error {
+				return formatter.Format(tableInstances)
+			})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected error containing %q, got %q", test.err, err)
+			}
+			if strings.HasSuffix(err.Error(), " ") {
+				t.Errorf("unexpected trailing space in %q", err)
+			}
+		})
+	}
+}
+
+func TestDefaultColumns(t *testing.T) {
+	types := []interface{}{
+		cloudca.AffinityGroup{},
+		cloudca.ComputeOffering{},
+		cloudca.Instance{},
+		cloudca.LoadBalancerRule{},
+		cloudca.Network{},
+		cloudca.NetworkAclRule{},
+		cloudca.PortForwardingRule{},
+		cloudca.PublicIp{},
+		cloudca.RemoteAccessVpn{},
+		cloudca.SSHKey{},
+		cloudca.Template{},
+		cloudca.Volume{},
+		cloudca.Vpc{},
+		configuration.Environment{},
+		configuration.User{},
+	}
+	tested := []string{}
+	for _, object := range types {
+		typ := reflect.TypeOf(object)
+		tested = append(tested, typ.String())
+		t.Run(typ.String(), func(t *testing.T) {
+			columns, ok := defaultColumns[typ.String()]
+			if !ok {
+				t.Fatal("no default columns")
+			}
+			for _, column := range columns {
+				if err := checkPath(typ, column); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+	curated := []string{}
+	for name := range defaultColumns {
+		curated = append(curated, name)
+	}
+	sort.Strings(tested)
+	sort.Strings(curated)
+	if !reflect.DeepEqual(tested, curated) {
+		t.Errorf("expected default columns of %v, got %v", tested, curated)
+	}
+}
+
+func TestRemoteAccessVpnColumns(t *testing.T) {
+	for _, column := range defaultColumns["cloudca.RemoteAccessVpn"] {
+		if column == "presharedKey" {
+			t.Error("the pre-shared key must not be a default column")
+		}
+	}
+}
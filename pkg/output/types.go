@@ -18,16 +18,24 @@ import (
 	"strings"
 )
 
-var outputFormats = []string{"json", "yaml"}
+var outputFormats = []string{"json", "yaml", "table", "csv", "tsv", "ndjson"}
+
+// templateFormats are the output formats taking a template,
+// provided as in --output jsonpath=<template>
+var templateFormats = []string{"jsonpath", "go-template"}
 
 // Get returns available output formats
 func Get() []string {
-	return outputFormats
+	return append(append([]string{}, outputFormats...), templateFormats...)
 }
 
 // Has returns true if the list of available output formats
 // contains the provided string, false if not
 func Has(format string) bool {
+	name, _, _ := splitFormat(format)
+	if isTemplateFormat(name) {
+		return true
+	}
 	for _, f := range outputFormats {
 		if format == f {
 			return true
@@ -41,12 +49,35 @@ func Has(format string) bool {
 func FormatStrings() string {
 	var b strings.Builder
 	b.WriteString("[")
-	for i, format := range outputFormats {
+	for _, format := range outputFormats {
 		b.WriteString(format)
-		if i+1 != len(outputFormats) {
+		b.WriteString(", ")
+	}
+	for i, format := range templateFormats {
+		b.WriteString(format + "=<template>")
+		if i+1 != len(templateFormats) {
 			b.WriteString(", ")
 		}
 	}
 	b.WriteString("]")
 	return b.String()
 }
+
+// splitFormat splits the name of the output format from its template
+func splitFormat(format string) (name string, template string, hasTemplate bool) {
+	parts := strings.SplitN(format, "=", 2)
+	if len(parts) == 1 {
+		return format, "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// isTemplateFormat returns true if the output format takes a template
+func isTemplateFormat(name string) bool {
+	for _, f := range templateFormats {
+		if name == f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cloud-ca/go-cloudca/configuration"
+)
+
+// ServiceConnection returns the service connection matching provided
+// name, id or service code
+func ServiceConnection(svc configuration.ServiceConnectionService, nameOrID string) (*configuration.ServiceConnection, error) {
+	connections, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	for i, c := range connections {
+		if c.ServiceCode == nameOrID {
+			return &connections[i], nil
+		}
+	}
+	candidates := make([]candidate, len(connections))
+	for i, c := range connections {
+		candidates[i] = candidate{id: c.Id, name: c.Name, detail: "service: " + c.ServiceCode}
+	}
+	i, err := find("service connection", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &connections[i], nil
+}
+
+// Organization returns the organization matching provided name,
+// id or entry point
+func Organization(svc configuration.OrganizationService, nameOrID string) (*configuration.Organization, error) {
+	organizations, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range organizations {
+		if o.EntryPoint == nameOrID {
+			return &organizations[i], nil
+		}
+	}
+	candidates := make([]candidate, len(organizations))
+	for i, o := range organizations {
+		candidates[i] = candidate{id: o.Id, name: o.Name, detail: "entry point: " + o.EntryPoint}
+	}
+	i, err := find("organization", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &organizations[i], nil
+}
+
+// User returns the user matching provided username or id
+func User(svc configuration.UserService, nameOrID string) (*configuration.User, error) {
+	users, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	return findUser(users, nameOrID)
+}
+
+func findUser(users []configuration.User, nameOrID string) (*configuration.User, error) {
+	candidates := make([]candidate, len(users))
+	for i, u := range users {
+		candidates[i] = candidate{id: u.Id, name: u.Username, detail: "organization: " + u.Organization.EntryPoint}
+	}
+	i, err := find("user", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &users[i], nil
+}
+
+// Roles returns the roles and their users from the assignments of users to
+// role names (e.g. "Environment Admin" => "alice,bob"). Users are matched by
+// username or id, and all the users assigned to any role are also returned.
+func Roles(svc configuration.UserService, assignments map[string]string) ([]configuration.Role, []configuration.User, error) {
+	all, err := svc.List()
+	if err != nil {
+		return nil, nil, err
+	}
+	roles := []configuration.Role{}
+	users := []configuration.User{}
+	seen := map[string]bool{}
+	names := make([]string, 0, len(assignments))
+	for name := range assignments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		list := assignments[name]
+		role := configuration.Role{Name: name, Users: []configuration.User{}}
+		for _, nameOrID := range strings.Split(list, ",") {
+			nameOrID = strings.TrimSpace(nameOrID)
+			if nameOrID == "" {
+				continue
+			}
+			user, err := findUser(all, nameOrID)
+			if err != nil {
+				return nil, nil, err
+			}
+			role.Users = append(role.Users, configuration.User{Id: user.Id})
+			if !seen[user.Id] {
+				seen[user.Id] = true
+				users = append(users, configuration.User{Id: user.Id})
+			}
+		}
+		roles = append(roles, role)
+	}
+	return roles, users, nil
+}
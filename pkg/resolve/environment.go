@@ -0,0 +1,40 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"github.com/cloud-ca/go-cloudca/configuration"
+)
+
+// Environment returns the environment matching provided name or id
+func Environment(svc configuration.EnvironmentService, nameOrID string) (*configuration.Environment, error) {
+	environments, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(environments))
+	for i, e := range environments {
+		candidates[i] = candidate{
+			id:     e.Id,
+			name:   e.Name,
+			detail: "service: " + e.ServiceConnection.ServiceCode + ", organization: " + e.Organization.EntryPoint,
+		}
+	}
+	i, err := find("environment", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &environments[i], nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+// Instance returns the instance matching provided name or id
+func Instance(svc cloudca.InstanceService, nameOrID string) (*cloudca.Instance, error) {
+	instances, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(instances))
+	for i, instance := range instances {
+		candidates[i] = candidate{id: instance.Id, name: instance.Name, detail: "network: " + instance.NetworkName}
+	}
+	i, err := find("instance", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &instances[i], nil
+}
+
+// Instances returns the instances matching provided names or ids,
+// listing the instances only once
+func Instances(svc cloudca.InstanceService, namesOrIDs []string) ([]cloudca.Instance, error) {
+	instances, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(instances))
+	for i, instance := range instances {
+		candidates[i] = candidate{id: instance.Id, name: instance.Name, detail: "network: " + instance.NetworkName}
+	}
+	found := make([]cloudca.Instance, len(namesOrIDs))
+	for i, nameOrID := range namesOrIDs {
+		j, err := find("instance", nameOrID, candidates)
+		if err != nil {
+			return nil, err
+		}
+		found[i] = instances[j]
+	}
+	return found, nil
+}
+
+// Template returns the template matching provided name or id
+func Template(svc cloudca.TemplateService, nameOrID string) (*cloudca.Template, error) {
+	templates, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(templates))
+	for i, template := range templates {
+		candidates[i] = candidate{id: template.ID, name: template.Name, detail: "os type: " + template.OSType}
+	}
+	i, err := find("template", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &templates[i], nil
+}
+
+// uuid matches the ids of cloud.ca entities
+var uuid = regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// OSTypeID returns the id of the OS type with provided name or id, looked up
+// in the existing templates as go-cloudca has no service listing the OS types.
+// An id which no template uses yet is returned as is.
+func OSTypeID(svc cloudca.TemplateService, nameOrID string) (string, error) {
+	templates, err := svc.List()
+	if err != nil {
+		return "", err
+	}
+	for _, template := range templates {
+		if template.OSTypeID != "" && (template.OSTypeID == nameOrID || strings.EqualFold(template.OSType, nameOrID)) {
+			return template.OSTypeID, nil
+		}
+	}
+	if uuid.MatchString(nameOrID) {
+		return nameOrID, nil
+	}
+	return "", fmt.Errorf("os type '%s' not found", nameOrID)
+}
+
+// ComputeOffering returns the compute offering matching provided name or id
+func ComputeOffering(svc cloudca.ComputeOfferingService, nameOrID string) (*cloudca.ComputeOffering, error) {
+	offerings, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(offerings))
+	for i, offering := range offerings {
+		candidates[i] = candidate{id: offering.Id, name: offering.Name}
+	}
+	i, err := find("compute offering", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &offerings[i], nil
+}
+
+// DiskOffering returns the disk offering matching provided name or id
+func DiskOffering(svc cloudca.DiskOfferingService, nameOrID string) (*cloudca.DiskOffering, error) {
+	offerings, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(offerings))
+	for i, offering := range offerings {
+		candidates[i] = candidate{id: offering.Id, name: offering.Name}
+	}
+	i, err := find("disk offering", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &offerings[i], nil
+}
+
+// AffinityGroup returns the affinity group matching provided name or id
+func AffinityGroup(svc cloudca.AffinityGroupService, nameOrID string) (*cloudca.AffinityGroup, error) {
+	groups, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(groups))
+	for i, group := range groups {
+		candidates[i] = candidate{id: group.Id, name: group.Name, detail: "type: " + group.Type}
+	}
+	i, err := find("affinity group", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &groups[i], nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+// Network returns the network matching provided name or id
+func Network(svc cloudca.NetworkService, nameOrID string) (*cloudca.Network, error) {
+	networks, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(networks))
+	for i, network := range networks {
+		candidates[i] = candidate{id: network.Id, name: network.Name, detail: "vpc: " + network.VpcId}
+	}
+	i, err := find("network", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &networks[i], nil
+}
+
+// NetworkOffering returns the network offering matching provided name or id
+func NetworkOffering(svc cloudca.NetworkOfferingService, nameOrID string) (*cloudca.NetworkOffering, error) {
+	offerings, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(offerings))
+	for i, offering := range offerings {
+		candidates[i] = candidate{id: offering.Id, name: offering.Name}
+	}
+	i, err := find("network offering", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &offerings[i], nil
+}
+
+// NetworkACL returns the network ACL matching provided name or id
+func NetworkACL(svc cloudca.NetworkAclService, nameOrID string) (*cloudca.NetworkAcl, error) {
+	acls, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(acls))
+	for i, acl := range acls {
+		candidates[i] = candidate{id: acl.Id, name: acl.Name, detail: "vpc: " + acl.VpcId}
+	}
+	i, err := find("network ACL", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &acls[i], nil
+}
+
+// Vpc returns the VPC matching provided name or id
+func Vpc(svc cloudca.VpcService, nameOrID string) (*cloudca.Vpc, error) {
+	vpcs, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(vpcs))
+	for i, vpc := range vpcs {
+		candidates[i] = candidate{id: vpc.Id, name: vpc.Name, detail: "zone: " + vpc.ZoneName}
+	}
+	i, err := find("VPC", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &vpcs[i], nil
+}
+
+// VpcOffering returns the VPC offering matching provided name or id
+func VpcOffering(svc cloudca.VpcOfferingService, nameOrID string) (*cloudca.VpcOffering, error) {
+	offerings, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(offerings))
+	for i, offering := range offerings {
+		candidates[i] = candidate{id: offering.Id, name: offering.Name}
+	}
+	i, err := find("VPC offering", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &offerings[i], nil
+}
+
+// Zone returns the zone matching provided name or id
+func Zone(svc cloudca.ZoneService, nameOrID string) (*cloudca.Zone, error) {
+	zones, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(zones))
+	for i, zone := range zones {
+		candidates[i] = candidate{id: zone.Id, name: zone.Name}
+	}
+	i, err := find("zone", nameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &zones[i], nil
+}
@@ -0,0 +1,36 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+// PublicIP returns the public IP matching provided address or id
+func PublicIP(svc cloudca.PublicIpService, addressOrID string) (*cloudca.PublicIp, error) {
+	ips, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(ips))
+	for i, ip := range ips {
+		candidates[i] = candidate{id: ip.Id, name: ip.IpAddress, detail: "vpc: " + ip.VpcName}
+	}
+	i, err := find("public IP", addressOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &ips[i], nil
+}
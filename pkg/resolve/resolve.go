@@ -0,0 +1,64 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resolve finds cloud.ca entities by either their name or id
+package resolve
+
+import (
+	"fmt"
+	"strings"
+)
+
+// candidate is an entity which can be matched by name or id
+type candidate struct {
+	id     string
+	name   string
+	detail string
+}
+
+// find returns the index of the only candidate whose id or name is equal to
+// 'nameOrID'. An id always wins over a name, and a name matching more than
+// one candidate is an error listing all of them.
+func find(kind string, nameOrID string, candidates []candidate) (int, error) {
+	if nameOrID == "" {
+		return -1, fmt.Errorf("%s name or id must be provided", kind)
+	}
+	for i, c := range candidates {
+		if c.id == nameOrID {
+			return i, nil
+		}
+	}
+	matches := []int{}
+	for i, c := range candidates {
+		if c.name == nameOrID {
+			matches = append(matches, i)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return -1, fmt.Errorf("%s '%s' not found", kind, nameOrID)
+	case 1:
+		return matches[0], nil
+	}
+	var b strings.Builder
+	for _, i := range matches {
+		c := candidates[i]
+		b.WriteString("\n  - " + c.name + " (id: " + c.id)
+		if c.detail != "" {
+			b.WriteString(", " + c.detail)
+		}
+		b.WriteString(")")
+	}
+	return -1, fmt.Errorf("%s name '%s' is ambiguous, use the id of one of the candidates:%s", kind, nameOrID, b.String())
+}
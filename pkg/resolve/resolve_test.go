@@ -0,0 +1,90 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"strings"
+	"testing"
+)
+
+var candidates = []candidate{
+	{id: "6b8f4a42-0000-4000-8000-000000000001", name: "web"},
+	{id: "6b8f4a42-0000-4000-8000-000000000002", name: "db", detail: "zone: ON1"},
+	{id: "6b8f4a42-0000-4000-8000-000000000003", name: "db", detail: "zone: QC1"},
+	{id: "6b8f4a42-0000-4000-8000-000000000004", name: "6b8f4a42-0000-4000-8000-000000000001"},
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name     string
+		nameOrID string
+		expected int
+	}{
+		{"by id", "6b8f4a42-0000-4000-8000-000000000002", 1},
+		{"by name", "web", 0},
+		{"id wins over name", "6b8f4a42-0000-4000-8000-000000000001", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i, err := find("instance", test.nameOrID, candidates)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if i != test.expected {
+				t.Errorf("expected candidate %d, got %d", test.expected, i)
+			}
+		})
+	}
+}
+
+func TestFindErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		nameOrID string
+		errs     []string
+	}{
+		{"empty", "", []string{"instance name or id must be provided"}},
+		{"not found", "app", []string{"instance 'app' not found"}},
+		{"not found by case", "WEB", []string{"instance 'WEB' not found"}},
+		{
+			"ambiguous name",
+			"db",
+			[]string{
+				"instance name 'db' is ambiguous",
+				"\n  - db (id: 6b8f4a42-0000-4000-8000-000000000002, zone: ON1)",
+				"\n  - db (id: 6b8f4a42-0000-4000-8000-000000000003, zone: QC1)",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i, err := find("instance", test.nameOrID, candidates)
+			if err == nil {
+				t.Fatalf("expected an error, got candidate %d", i)
+			}
+			for _, expected := range test.errs {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q, got %q", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestFindNoCandidates(t *testing.T) {
+	if _, err := find("instance", "web", nil); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolve
+
+import (
+	"github.com/cloud-ca/go-cloudca/services/cloudca"
+)
+
+// RemoteAccessVpn returns the remote access VPN matching provided public IP address or id
+func RemoteAccessVpn(svc cloudca.RemoteAccessVpnService, addressOrID string) (*cloudca.RemoteAccessVpn, error) {
+	vpns, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(vpns))
+	for i, vpn := range vpns {
+		candidates[i] = candidate{id: vpn.Id, name: vpn.PublicIpAddress, detail: "state: " + vpn.State}
+	}
+	i, err := find("VPN", addressOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &vpns[i], nil
+}
+
+// RemoteAccessVpnUser returns the VPN user matching provided username or id
+func RemoteAccessVpnUser(svc cloudca.RemoteAccessVpnUserService, usernameOrID string) (*cloudca.RemoteAccessVpnUser, error) {
+	users, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]candidate, len(users))
+	for i, user := range users {
+		candidates[i] = candidate{id: user.Id, name: user.Username}
+	}
+	i, err := find("VPN user", usernameOrID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return &users[i], nil
+}
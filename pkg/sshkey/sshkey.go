@@ -0,0 +1,103 @@
+// Copyright © 2019 cloud.ca Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sshkey validates and generates SSH keys in the OpenSSH formats
+package sshkey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// DefaultBits is the size of the generated RSA keys
+const DefaultBits = 4096
+
+// keyTypes are the types of public keys accepted by cloud.ca
+var keyTypes = map[string]bool{
+	ssh.KeyAlgoRSA:      true,
+	ssh.KeyAlgoDSA:      true,
+	ssh.KeyAlgoED25519:  true,
+	ssh.KeyAlgoECDSA256: true,
+	ssh.KeyAlgoECDSA384: true,
+	ssh.KeyAlgoECDSA521: true,
+}
+
+// ParsePublicKey validates a public key in the authorized_keys format, i.e.
+// '<type> <base64 key> [comment]', and returns it without trailing newline
+func ParsePublicKey(data []byte) (string, error) {
+	key := strings.TrimSpace(string(data))
+	if strings.HasPrefix(key, "-----BEGIN") {
+		return "", errors.New("not a public key, the file looks like a private key")
+	}
+	if strings.Contains(key, "\n") {
+		return "", errors.New("not a public key, the file must contain a single key")
+	}
+	publicKey, _, options, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return "", fmt.Errorf("invalid public key: %s", err)
+	}
+	if len(options) > 0 {
+		return "", errors.New("not a public key, authorized_keys options are not supported")
+	}
+	// the parsed type comes from the key itself, which must match the given one
+	keyType := strings.Fields(key)[0]
+	if !keyTypes[keyType] {
+		return "", fmt.Errorf("unsupported public key type '%s'", keyType)
+	}
+	if publicKey.Type() != keyType {
+		return "", fmt.Errorf("invalid public key, its content is not of type '%s'", keyType)
+	}
+	return key, nil
+}
+
+// Generate creates an RSA keypair, returning the private key PEM encoded
+// and the public key in the authorized_keys format with provided comment
+func Generate(bits int, comment string) ([]byte, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, "", err
+	}
+	private := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	publicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, "", err
+	}
+	public := string(bytes.TrimSuffix(ssh.MarshalAuthorizedKey(publicKey), []byte("\n")))
+	if comment != "" {
+		public += " " + comment
+	}
+	return private, public, nil
+}
+
+// Fingerprint returns the colon separated MD5 fingerprint of a public key in
+// the authorized_keys format
+func Fingerprint(key string) (string, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return "", fmt.Errorf("invalid public key: %s", err)
+	}
+	return ssh.FingerprintLegacyMD5(publicKey), nil
+}